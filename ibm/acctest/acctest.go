@@ -12,6 +12,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -2071,11 +2072,28 @@ var (
 // Provider be errantly reused in ProviderFactories.
 var testAccProviderConfigure sync.Once
 
+// replayCredentials are the placeholders of the credentials required by
+// TestAccPreCheck when the tests replay the HTTP interactions of a cassette
+// (IBMCLOUD_RECORDER_MODE=replay), in which case no request reaches IBM Cloud.
+var replayCredentials = map[string]string{
+	"IC_API_KEY":            "replay",
+	"IAAS_CLASSIC_API_KEY":  "replay",
+	"IAAS_CLASSIC_USERNAME": "replay",
+}
+
 func init() {
 	TestAccProvider = provider.Provider()
 	TestAccProviders = map[string]*schema.Provider{
 		ProviderName: TestAccProvider,
 	}
+
+	if os.Getenv("IBMCLOUD_RECORDER_MODE") == conns.RecorderModeReplay {
+		for name, value := range replayCredentials {
+			if os.Getenv(name) == "" {
+				os.Setenv(name, value)
+			}
+		}
+	}
 }
 
 func TestProvider(t *testing.T) {
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Compute resources that the provider can authenticate from, used as values
+// of Config.ComputeResourceAuth
+const (
+	// The VPC instance identity token of the instance metadata service
+	ComputeResourceAuthVPC = "vpc"
+	// A projected Kubernetes service account token, in IKS and OpenShift pods
+	ComputeResourceAuthContainer = "container"
+)
+
+// ComputeResourceAuthTypes lists the supported values of Config.ComputeResourceAuth.
+var ComputeResourceAuthTypes = []string{ComputeResourceAuthVPC, ComputeResourceAuthContainer}
+
+// newComputeResourceAuthenticator builds the authenticator exchanging the
+// token of the compute resource the provider runs on for an IAM access token
+// of the trusted profile. Both authenticators refresh the IAM token on their
+// own, so no long-lived credential is involved.
+func (c *Config) newComputeResourceAuthenticator(iamURL string) (core.Authenticator, error) {
+	if c.BluemixAPIKey != "" || c.IAMToken != "" || c.IAMRefreshToken != "" {
+		return nil, fmt.Errorf("[ERROR] Compute resource authentication cannot be combined with an API key or an IAM token")
+	}
+
+	var authenticator core.Authenticator
+	switch c.ComputeResourceAuth {
+	case ComputeResourceAuthVPC:
+		vpcAuthenticator := &core.VpcInstanceAuthenticator{
+			IAMProfileCRN: c.IAMProfileCRN,
+			IAMProfileID:  c.IAMTrustedProfileID,
+			URL:           c.MetadataServiceURL,
+		}
+		if c.recorder != nil {
+			vpcAuthenticator.Client = c.recorder.HTTPClient(c.BluemixTimeout)
+		}
+		authenticator = vpcAuthenticator
+	case ComputeResourceAuthContainer:
+		containerAuthenticator := &core.ContainerAuthenticator{
+			CRTokenFilename: c.CRTokenFilename,
+			IAMProfileName:  c.IAMProfileName,
+			IAMProfileID:    c.IAMTrustedProfileID,
+			URL:             EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+		}
+		if c.recorder != nil {
+			containerAuthenticator.Client = c.recorder.HTTPClient(c.BluemixTimeout)
+		}
+		authenticator = containerAuthenticator
+	default:
+		return nil, fmt.Errorf("[ERROR] Unsupported compute resource authentication %q, expected one of %q", c.ComputeResourceAuth, ComputeResourceAuthTypes)
+	}
+
+	if err := authenticator.Validate(); err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid %s compute resource authentication: %s", c.ComputeResourceAuth, err)
+	}
+	return authenticator, nil
+}
+
+// bearerToken returns the Authorization header value carrying an IAM access
+// token of authenticator, for the clients that are not based on go-sdk-core.
+func bearerToken(authenticator core.Authenticator) (string, error) {
+	tokenAuthenticator, ok := authenticator.(interface{ GetToken() (string, error) })
+	if !ok {
+		return "", fmt.Errorf("[ERROR] The %s authenticator does not provide IAM tokens", authenticator.AuthenticationType())
+	}
+	log.Printf("[INFO] Requesting an IAM access token with the %s authenticator", authenticator.AuthenticationType())
+	token, err := tokenAuthenticator.GetToken()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error occured while requesting an IAM access token with the %s authenticator: %s", authenticator.AuthenticationType(), err)
+	}
+	return "Bearer " + token, nil
+}
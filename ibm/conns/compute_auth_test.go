@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// newMetadataServiceStub serves the token operations of the VPC instance
+// metadata service, and the IAM token operation used by the container
+// authenticator.
+func newMetadataServiceStub(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now().UTC()
+		expiresAt := now.Add(time.Hour)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/instance_identity/v1/token":
+			fmt.Fprintf(w, `{"access_token":"instance-identity-token","created_at":%q,"expires_at":%q,"expires_in":300}`, now.Format(time.RFC3339), now.Add(5*time.Minute).Format(time.RFC3339))
+		case "/instance_identity/v1/iam_token":
+			if r.Header.Get("Authorization") != "Bearer instance-identity-token" {
+				t.Errorf("expected the instance identity token, got %q", r.Header.Get("Authorization"))
+			}
+			fmt.Fprintf(w, `{"access_token":"vpc-iam-token","created_at":%q,"expires_at":%q,"expires_in":3600}`, now.Format(time.RFC3339), expiresAt.Format(time.RFC3339))
+		case "/identity/token":
+			r.ParseForm()
+			if r.Form.Get("cr_token") != "service-account-token" || r.Form.Get("profile_name") != "ci-runner" {
+				t.Errorf("unexpected container token request %v", r.Form)
+			}
+			fmt.Fprintf(w, `{"access_token":"container-iam-token","refresh_token":"","token_type":"Bearer","expires_in":3600,"expiration":%d}`, expiresAt.Unix())
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestComputeResourceAuthVPC(t *testing.T) {
+	server := newMetadataServiceStub(t)
+	defer server.Close()
+
+	c := &Config{
+		ComputeResourceAuth: ComputeResourceAuthVPC,
+		IAMTrustedProfileID: "Profile-1",
+		MetadataServiceURL:  server.URL,
+		Region:              "us-south",
+		RetryDelay:          time.Millisecond,
+	}
+	session, err := c.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sess := session.(*clientSession)
+	if token := sess.session.BluemixSession.Config.IAMAccessToken; token != "Bearer vpc-iam-token" {
+		t.Errorf("unexpected IAM access token %q", token)
+	}
+	if c.tokenAuthenticator.AuthenticationType() != core.AUTHTYPE_VPC {
+		t.Errorf("unexpected authenticator %s", c.tokenAuthenticator.AuthenticationType())
+	}
+}
+
+func TestComputeResourceAuthContainer(t *testing.T) {
+	server := newMetadataServiceStub(t)
+	defer server.Close()
+	os.Setenv("IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+	defer os.Unsetenv("IBMCLOUD_IAM_API_ENDPOINT")
+
+	tokenFile := filepath.Join(t.TempDir(), "sa-token")
+	if err := os.WriteFile(tokenFile, []byte("service-account-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{
+		ComputeResourceAuth: ComputeResourceAuthContainer,
+		IAMProfileName:      "ci-runner",
+		CRTokenFilename:     tokenFile,
+	}
+	authenticator, err := c.newComputeResourceAuthenticator("https://iam.cloud.ibm.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := bearerToken(authenticator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "Bearer container-iam-token" {
+		t.Errorf("unexpected IAM access token %q", token)
+	}
+}
+
+func TestComputeResourceAuthErrors(t *testing.T) {
+	tests := map[string]*Config{
+		"api key":         {ComputeResourceAuth: ComputeResourceAuthVPC, BluemixAPIKey: "key"},
+		"unknown type":    {ComputeResourceAuth: "lambda"},
+		"missing profile": {ComputeResourceAuth: ComputeResourceAuthContainer},
+		"profile id and crn": {
+			ComputeResourceAuth: ComputeResourceAuthVPC,
+			IAMTrustedProfileID: "Profile-1",
+			IAMProfileCRN:       "crn:v1:bluemix:public:iam-identity::a/123::profile:Profile-1",
+		},
+	}
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := c.newComputeResourceAuthenticator("https://iam.cloud.ibm.com"); err == nil || !strings.Contains(err.Error(), "[ERROR]") {
+				t.Errorf("expected an error, got %v", err)
+			}
+		})
+	}
+}
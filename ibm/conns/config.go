@@ -4,18 +4,17 @@
 package conns
 
 import (
+	"context"
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
-	"io"
-	"io/ioutil"
 	"log"
 	"net"
 	gohttp "net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -130,6 +129,7 @@ import (
 	"github.com/IBM/platform-services-go-sdk/partnercentersellv1"
 	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"golang.org/x/time/rate"
 )
 
 // RetryAPIDelay - retry api delay
@@ -181,6 +181,20 @@ type Config struct {
 	// Constant Retry Delay for API calls
 	RetryDelay time.Duration
 
+	// Retry policy applied to every go-sdk-core based service client, see EnableRetries
+	RetryMaxAttempts   int
+	RetryMaxBackoff    time.Duration
+	RetryOnStatusCodes []int
+
+	// Client-side rate limits, in requests per second, keyed by RateLimitServices
+	RateLimits map[string]float64
+
+	// HTTP recorder mode (RecorderModeRecord or RecorderModeReplay) and cassette file
+	RecorderMode     string
+	RecorderCassette string
+
+	recorder *Recorder
+
 	// FunctionNameSpace ...
 	FunctionNameSpace string
 
@@ -199,10 +213,38 @@ type Config struct {
 	// IAM Refresh Token
 	IAMRefreshToken string
 
+	// Compute resource authentication (ComputeResourceAuthVPC or ComputeResourceAuthContainer),
+	// with the trusted profile given by IAMTrustedProfileID, IAMProfileName or IAMProfileCRN
+	ComputeResourceAuth string
+	IAMProfileName      string
+	IAMProfileCRN       string
+	// Service account token file of ComputeResourceAuthContainer
+	CRTokenFilename string
+	// Instance metadata service of ComputeResourceAuthVPC
+	MetadataServiceURL string
+
+	// Trusted profile assumed with BluemixAPIKey
+	AssumeTrustedProfile *AssumeTrustedProfile
+
+	// Authenticator of ComputeResourceAuth or AssumeTrustedProfile, which
+	// provides the IAM access token of every client
+	tokenAuthenticator core.Authenticator
+	// Refreshes the IAM access token of every client, when the credentials
+	// allow it
+	tokenManager *tokenManager
+	// Cancelled when Terraform stops the provider, which ends the background
+	// refresh of the IAM access token
+	StopContext context.Context
+
 	// Zone
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+	// Endpoints is the parsed EndpointsFile, see LoadEndpointsFile
+	Endpoints EndpointsFile
+
+	// DefaultTags are attached to every taggable resource
+	DefaultTags []string
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -317,11 +359,22 @@ type ClientSession interface {
 	MqcloudV1() (*mqcloudv1.MqcloudV1, error)
 	VmwareV1() (*vmwarev1.VmwareV1, error)
 	LogsV0() (*logsv0.LogsV0, error)
+	DefaultTags() []string
 }
 
 type clientSession struct {
 	session *Session
 
+	defaultTags []string
+
+	lazyClients map[string]*lazyClient
+
+	rateLimiters map[string]*rate.Limiter
+
+	recorder *Recorder
+
+	tokenManager *tokenManager
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -676,65 +729,81 @@ type clientSession struct {
 }
 
 // Usage Reports
-func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+func (session *clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	session.load("usageReportsClient")
 	return session.usageReportsClient, session.usageReportsClientErr
 }
 
-func (session clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+func (session *clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+	session.load("partnerCenterSellClient")
 	return session.partnerCenterSellClient, session.partnerCenterSellClientErr
 }
 
 // Configuration Aggregator
-func (session clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+func (session *clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+	session.load("configurationAggregatorClient")
 	return session.configurationAggregatorClient, session.configurationAggregatorClientErr
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.load("appidAPI")
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.load("catalogManagementClient")
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.load("bmxAccountServiceAPI")
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.load("bmxAccountv1ServiceAPI")
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
+	sess.load(bluemixAuthKey)
+	if sess.tokenManager != nil {
+		return sess.tokenManager.bluemixSession(sess.session.BluemixSession), sess.bluemixSessionErr
+	}
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
+	sess.load(bluemixAuthKey)
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.load("csServiceAPI")
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.load("csv2ServiceAPI")
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.load("containerRegistryClient")
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.load("schematicsClient")
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -743,127 +812,156 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.load(bluemixAuthKey)
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.load("globalSearchServiceAPI")
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.load("globalSearchServiceAPI")
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.load("globalTaggingServiceAPIV1")
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.load("globalTaggingServiceAPIV1")
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.load("hpcsEndpointAPI")
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.load("ukoClient")
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.load("userManagementAPI")
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.load("iamPolicyManagementAPI")
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.load("iamAccessGroupsAPI")
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.load("ibmCloudShellClient")
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.load("icdServiceAPI")
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.load("cloudDatabasesClient")
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // IBM Db2 SaaS on Cloud REST API
-func (session clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+func (session *clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+	session.load("db2saasClient")
 	return session.db2saasClient, session.db2saasClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.load("cfServiceAPI")
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.load("resourceCatalogServiceAPI")
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.load("resourceManagementServiceAPIv2")
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.load("resourceControllerServiceAPI")
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.load("resourceControllerServiceAPIv2")
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
+	sess.load(bluemixAuthKey)
+	if sess.tokenManager != nil {
+		return sess.tokenManager.softLayerSession(sess.session.SoftLayerSession)
+	}
 	return sess.session.SoftLayerSession
 }
 
 // apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.load("apigatewayAPI")
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.load("pushServiceClient")
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.load("eventNotificationsApiClient")
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.load("appConfigurationClient")
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.load("kpAPI")
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.load("kmsAPI")
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -882,59 +980,69 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.recorder.Transport(DefaultTransport()))
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return nil, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.load("vpcAPI")
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+func (sess *clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+	sess.load("vpcAPI")
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.load("directlinkAPI")
 	return sess.directlinkAPI, sess.directlinkErr
 }
 
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.load("directlinkAPI")
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
 
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.load("cosConfigAPI")
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.load("transitgatewayAPI")
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.load("ibmpiSession")
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.load("pDNSClient")
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.load("functionIAMNamespaceAPI")
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.load("cisZonesV1Client")
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -942,7 +1050,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.load("cisDNSRecordsClient")
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -950,7 +1059,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.load("cisDNSRecordBulkClient")
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -958,7 +1068,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.load("cisGLBPoolClient")
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -966,7 +1077,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.load("cisGLBClient")
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -974,7 +1086,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.load("cisGLBHealthCheckClient")
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -982,7 +1095,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.load("cisRLClient")
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -990,7 +1104,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.load("cisIPClient")
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -998,7 +1113,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.load("cisPageRuleClient")
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -1006,7 +1122,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.load("cisEdgeFunctionClient")
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -1014,7 +1131,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.load("cisSSLClient")
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -1022,7 +1140,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.load("cisWAFPackageClient")
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -1030,7 +1149,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.load("cisDomainSettingsClient")
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -1038,7 +1158,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.load("cisRLClient")
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -1046,7 +1167,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Rulesets
-func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+func (sess *clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+	sess.load("cisRulesetsClient")
 	if sess.cisRulesetsErr != nil {
 		return sess.cisRulesetsClient, sess.cisRulesetsErr
 	}
@@ -1054,7 +1176,8 @@ func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.load("cisRoutingClient")
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -1062,7 +1185,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.load("cisWAFGroupClient")
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -1070,7 +1194,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.load("cisCacheClient")
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -1078,7 +1203,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.load("cisCustomPageClient")
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -1086,7 +1212,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.load("cisAccessRuleClient")
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1094,7 +1221,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.load("cisUARuleClient")
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1102,7 +1230,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.load("cisLockdownClient")
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1110,7 +1239,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.load("cisRangeAppClient")
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1118,7 +1248,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.load("cisWAFRuleClient")
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1126,7 +1257,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.load("cisOriginAuthClient")
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1134,43 +1266,51 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.load("iamIdentityAPI")
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.load("resourceManagerAPI")
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.load("enterpriseManagementClient")
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.load("resourceControllerAPI")
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
-func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+func (session *clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	session.load("secretsManagerClient")
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.load("satelliteLinkClient")
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.load("satelliteClient")
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.load("cisLogpushJobsClient")
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1178,7 +1318,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.load("cisMtlsClient")
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1186,7 +1327,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Bot Management
-func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+func (sess *clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+	sess.load("cisBotManagementClient")
 	if sess.cisBotManagementErr != nil {
 		return sess.cisBotManagementClient, sess.cisBotManagementErr
 	}
@@ -1194,7 +1336,8 @@ func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotMana
 }
 
 // CIS Bot Analytics
-func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+func (sess *clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+	sess.load("cisBotAnalyticsClient")
 	if sess.cisBotAnalyticsErr != nil {
 		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
 	}
@@ -1202,7 +1345,8 @@ func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyt
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.load("cisWebhooksClient")
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1210,7 +1354,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.load("cisWebhooksClient")
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1218,7 +1363,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.load("cisFirewallRulesClient")
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1226,55 +1372,66 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.load("atrackerClientV2")
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
 // Metrics Router API Version 3
-func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+func (session *clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+	session.load("metricsRouterClient")
 	return session.metricsRouterClient, session.metricsRouterClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.load("esSchemaRegistryClient")
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
-func (session clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+func (session *clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+	session.load("esAdminRestClient")
 	return session.esAdminRestClient, session.esAdminRestErr
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+func (session *clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+	session.load("securityAndComplianceCenterClient")
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.load("contextBasedRestrictionsClient")
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.load("cdToolchainClient")
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.load("cdTektonPipelineClient")
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // Code Engine
-func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+func (session *clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+	session.load("codeEngineClient")
 	return session.codeEngineClient, session.codeEngineClientErr
 }
 
 // Projects API Specification
-func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
+func (session *clientSession) ProjectV1() (*project.ProjectV1, error) {
+	session.load("projectClient")
 	return session.projectClient, session.projectClientErr
 }
 
 // MQaaS
-func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+func (session *clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+	session.load("mqcloudClient")
 	if session.mqcloudClientErr != nil {
 		sessionMqcloudClient := session.mqcloudClient
 		sessionMqcloudClient.EnableRetries(0, 0)
@@ -1284,29 +1441,144 @@ func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
 }
 
 // VMware as a Service API
-func (session clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+func (session *clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+	session.load("vmwareClient")
 	return session.vmwareClient, session.vmwareClientErr
 }
 
 // Cloud Logs
-func (session clientSession) LogsV0() (*logsv0.LogsV0, error) {
+func (session *clientSession) LogsV0() (*logsv0.LogsV0, error) {
+	session.load("logsClient")
 	return session.logsClient, session.logsClientErr
 }
 
 // IBM Cloud Logs Routing
-func (session clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+func (session *clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+	session.load("ibmCloudLogsRoutingClient")
 	return session.ibmCloudLogsRoutingClient, session.ibmCloudLogsRoutingClientErr
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// DefaultTags returns the provider level default tags, including the tags
+// sourced from the IC_ENV_TAGS environment variable
+func (session *clientSession) DefaultTags() []string {
+	return session.defaultTags
+}
+
+// bluemixAuthKey identifies the lazy initializer that authenticates the
+// IBM Cloud session and fetches the account user details.
+const bluemixAuthKey = "bluemixAuth"
+
+// lazyClient builds one or more service clients of a clientSession the first
+// time they are requested. The outcome, including any configuration error,
+// is kept in the clientSession fields and reused by every later call.
+type lazyClient struct {
+	once sync.Once
+	init func()
+}
+
+// lazy registers init as the initializer for the clients identified by key.
+// It must only be called while the session is being configured.
+func (session *clientSession) lazy(key string, init func()) {
+	if session.lazyClients == nil {
+		session.lazyClients = make(map[string]*lazyClient)
+	}
+	session.lazyClients[key] = &lazyClient{init: init}
+}
+
+// load runs the initializer registered for key at most once. Keys without an
+// initializer, e.g. when the IBM Cloud credentials are missing, are ignored.
+func (session *clientSession) load(key string) {
+	if client, ok := session.lazyClients[key]; ok {
+		client.once.Do(client.init)
+	}
+}
+
+// ClientSession configures and returns a ClientSession. Service clients are
+// built lazily, on the first call to their accessor.
 func (c *Config) ClientSession() (interface{}, error) {
+	if c.RecorderMode != "" {
+		recorder, err := NewRecorder(c.RecorderMode, c.RecorderCassette)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[INFO] HTTP recorder in %s mode, using cassette %s\n", c.RecorderMode, c.RecorderCassette)
+		c.recorder = recorder
+	}
+
+	fileMap := c.Endpoints
+	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
+		if fileMap == nil {
+			var err error
+			fileMap, _, err = LoadEndpointsFile(f)
+			if err != nil {
+				return nil, err
+			}
+		}
+		endpointsFiles.Store(f, fileMap)
+	}
+
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+
+	if c.ComputeResourceAuth != "" {
+		authenticator, err := c.newComputeResourceAuthenticator(iamURL)
+		if err != nil {
+			return nil, err
+		}
+		if c.IAMToken, err = bearerToken(authenticator); err != nil {
+			return nil, err
+		}
+		c.tokenAuthenticator = authenticator
+	}
+	if c.AssumeTrustedProfile != nil {
+		authenticator, err := c.newAssumeTrustedProfileAuthenticator(iamURL)
+		if err != nil {
+			return nil, err
+		}
+		// Every client acts as the trusted profile, so the clients are built
+		// from a copy of the configuration without the API key, which only the
+		// authenticator uses.
+		profileConfig := *c
+		profileConfig.BluemixAPIKey = ""
+		if profileConfig.IAMToken, err = bearerToken(authenticator); err != nil {
+			return nil, err
+		}
+		profileConfig.tokenAuthenticator = authenticator
+		c = &profileConfig
+	}
+
+	c.tokenManager = c.newTokenManager(iamURL)
+
 	sess, err := newSession(c)
 	if err != nil {
 		return nil, err
 	}
+	if c.tokenManager != nil {
+		stopContext := c.StopContext
+		if stopContext == nil {
+			stopContext = context.Background()
+		}
+		c.tokenManager.start(stopContext)
+	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
-		session: sess,
+	session := &clientSession{
+		session:      sess,
+		defaultTags:  append([]string{}, c.DefaultTags...),
+		rateLimiters: newRateLimiters(c.RateLimits),
+		recorder:     c.recorder,
+		tokenManager: c.tokenManager,
+	}
+	if envTags := os.Getenv("IC_ENV_TAGS"); envTags != "" {
+		session.defaultTags = append(session.defaultTags, strings.Split(envTags, ",")...)
 	}
 
 	if sess.BluemixSession == nil {
@@ -1399,200 +1671,187 @@ func (c *Config) ClientSession() (interface{}, error) {
 		return session, nil
 	}
 
-	if sess.BluemixSession.Config.BluemixAPIKey != "" {
-		err = authenticateAPIKey(sess.BluemixSession)
+	if c.tokenAuthenticator == nil && c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
+		err := RefreshToken(sess.BluemixSession)
 		if err != nil {
 			for count := c.RetryCount; count >= 0; count-- {
 				if err == nil || !isRetryable(err) {
 					break
 				}
 				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying IAM Authentication %d", count)
-				err = authenticateAPIKey(sess.BluemixSession)
+				log.Printf("Retrying refresh token %d", count)
+				err = RefreshToken(sess.BluemixSession)
 			}
 			if err != nil {
-				session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
+				return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
 			}
 		}
-
-		session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 	}
 
-	if c.IAMTrustedProfileID == "" && sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
-		err := RefreshToken(sess.BluemixSession)
-		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying refresh token %d", count)
-				err = RefreshToken(sess.BluemixSession)
-			}
+	// Authenticating the IBM Cloud session and fetching the account user
+	// details are deferred until a client that depends on them is first used.
+	session.lazy(bluemixAuthKey, func() {
+		if sess.BluemixSession.Config.BluemixAPIKey != "" {
+			err := authenticateAPIKey(sess.BluemixSession)
 			if err != nil {
-				return nil, fmt.Errorf("[ERROR] Error occured while refreshing the token: %q", err)
+				for count := c.RetryCount; count >= 0; count-- {
+					if err == nil || !isRetryable(err) {
+						break
+					}
+					time.Sleep(c.RetryDelay)
+					log.Printf("Retrying IAM Authentication %d", count)
+					err = authenticateAPIKey(sess.BluemixSession)
+				}
+				if err != nil {
+					session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for account user details: %q", err)
+					session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
+				}
 			}
+
+			session.functionConfigErr = fmt.Errorf("[ERROR] Error occured while fetching auth key for function: %q", err)
 		}
 
-	}
-	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
-	if err != nil {
-		session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
-	}
-	session.bmxUserDetails = userConfig
+		userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
+		if err != nil {
+			session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
+		}
+		session.bmxUserDetails = userConfig
 
-	if sess.SoftLayerSession != nil && sess.SoftLayerSession.APIKey == "" {
-		log.Println("Configuring SoftLayer Session with token from IBM Cloud Session")
-		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
-		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
-	}
+		if sess.SoftLayerSession != nil && sess.SoftLayerSession.APIKey == "" {
+			log.Println("Configuring SoftLayer Session with token from IBM Cloud Session")
+			sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
+			sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
+		}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+		session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+	})
 
 	BluemixRegion = sess.BluemixSession.Config.Region
-	var fileMap map[string]interface{}
-	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
+	session.lazy("bmxAccountv1ServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		accv1API, err := accountv1.New(sess.BluemixSession)
 		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
+			session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 		}
-		defer jsonFile.Close()
-		bytes, err := ioutil.ReadAll(jsonFile)
+		session.bmxAccountv1ServiceAPI = accv1API
+	})
+
+	session.lazy("bmxAccountServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		accAPI, err := accountv2.New(sess.BluemixSession)
 		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
+			session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
+		session.bmxAccountServiceAPI = accAPI
+	})
+
+	session.lazy("cfServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		cfAPI, err := mccpv2.New(sess.BluemixSession)
 		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+			session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 		}
-	}
-	accv1API, err := accountv1.New(sess.BluemixSession)
-	if err != nil {
-		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
-	}
-	session.bmxAccountv1ServiceAPI = accv1API
-
-	accAPI, err := accountv2.New(sess.BluemixSession)
-	if err != nil {
-		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
-	}
-	session.bmxAccountServiceAPI = accAPI
-
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
-	if err != nil {
-		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
-	}
-	session.cfServiceAPI = cfAPI
+		session.cfServiceAPI = cfAPI
+	})
 
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
-	if err != nil {
-		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
-	}
-	session.csServiceAPI = clusterAPI
+	session.lazy("csServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		clusterAPI, err := containerv1.New(sess.BluemixSession)
+		if err != nil {
+			session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
+		}
+		session.csServiceAPI = clusterAPI
+	})
 
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
-	}
-	session.csv2ServiceAPI = v2clusterAPI
+	session.lazy("csv2ServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		v2clusterAPI, err := containerv2.New(sess.BluemixSession)
+		if err != nil {
+			session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
+		}
+		session.csv2ServiceAPI = v2clusterAPI
+	})
 
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
-	if err != nil {
-		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
-	}
-	session.hpcsEndpointAPI = hpcsAPI
+	session.lazy("hpcsEndpointAPI", func() {
+		session.load(bluemixAuthKey)
+		hpcsAPI, err := hpcs.New(sess.BluemixSession)
+		if err != nil {
+			session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
+		}
+		session.hpcsEndpointAPI = hpcsAPI
+	})
 
-	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
-	}
-	var options kp.ClientConfig
-	if c.BluemixAPIKey != "" {
-		options = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+	session.lazy("kpAPI", func() {
+		session.load(bluemixAuthKey)
+		kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 		}
-	} else {
-		options = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-			Verbose: kp.VerboseFailOnly,
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
 		}
-	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
-	if err != nil {
-		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
-	}
-	session.kpAPI = kpAPIclient
-
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		var options kp.ClientConfig
+		if c.BluemixAPIKey != "" {
+			options = kp.ClientConfig{
+				BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
 		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+			options = kp.ClientConfig{
+				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+				Verbose: kp.VerboseFailOnly,
+			}
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
+		kpAPIclient, err := kp.New(options, c.recorder.Transport(DefaultTransport()))
+		if err != nil {
+			session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+		}
+		session.kpAPI = kpAPIclient
+	})
 
-	// KEY MANAGEMENT Service
-	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
-	}
-	var kmsOptions kp.ClientConfig
-	if c.BluemixAPIKey != "" {
-		kmsOptions = kp.ClientConfig{
-			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+	session.lazy("kmsAPI", func() {
+		session.load(bluemixAuthKey)
+		// KEY MANAGEMENT Service
+		kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
 		}
-	} else {
-		kmsOptions = kp.ClientConfig{
-			BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-			Authorization: sess.BluemixSession.Config.IAMAccessToken,
-			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-			Verbose:  kp.VerboseFailOnly,
-			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
 		}
-	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
-	if err != nil {
-		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
-	}
-	session.kmsAPI = kmsAPIclient
-
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+		var kmsOptions kp.ClientConfig
 		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			kmsOptions = kp.ClientConfig{
+				BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 			}
 		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			kmsOptions = kp.ClientConfig{
+				BaseURL:       EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
+				Authorization: sess.BluemixSession.Config.IAMAccessToken,
+				// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+				Verbose:  kp.VerboseFailOnly,
+				TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 			}
 		}
+		kmsAPIclient, err := kp.New(kmsOptions, c.recorder.Transport(DefaultTransport()))
+		if err != nil {
+			session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
+		}
+		session.kmsAPI = kmsAPIclient
+	})
+
+	var authenticator core.Authenticator
+
+	if c.tokenManager != nil {
+		authenticator = c.tokenManager.authenticator
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
 		authenticator = &core.BearerTokenAuthenticator{
 			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
@@ -1603,1959 +1862,2205 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 	}
 
-	projectEndpoint := project.DefaultServiceURL
-	// Construct an "options" struct for creating the service client.
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
-	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		session.projectClientErr = fmt.Errorf("Project Service API does not support private endpoints")
-	}
-	// Construct an "options" struct for creating the service client.
-	projectClientOptions := &project.ProjectV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
-		Authenticator: authenticator,
-	}
+	session.lazy("projectClient", func() {
+		var err error
+		projectEndpoint := project.DefaultServiceURL
+		// Construct an "options" struct for creating the service client.
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			projectEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PROJECT_API_ENDPOINT", c.Region, project.DefaultServiceURL)
+		}
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			session.projectClientErr = fmt.Errorf("Project Service API does not support private endpoints")
+		}
+		// Construct an "options" struct for creating the service client.
+		projectClientOptions := &project.ProjectV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PROJECT_API_ENDPOINT"}, projectEndpoint),
+			Authenticator: authenticator,
+		}
 
-	// Construct the service client.
-	session.projectClient, err = project.NewProjectV1(projectClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.projectClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
-	}
+		// Construct the service client.
+		session.projectClient, err = project.NewProjectV1(projectClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.projectClient.Service)
+			// Add custom header for analytics
+			session.projectClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
+		}
+	})
 
-	// Construct an "options" struct for creating the service client.
-	logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
+	session.lazy("logsClient", func() {
+		var err error
+		// Construct an "options" struct for creating the service client.
+		logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		logsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_API_ENDPOINT", c.Region, logsEndpoint)
-	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		logsEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.logs", c.Region), cloudEndpoint)
-	}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			logsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_LOGS_API_ENDPOINT", c.Region, logsEndpoint)
+		}
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			logsEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.logs", c.Region), cloudEndpoint)
+		}
 
-	logsClientOptions := &logsv0.LogsV0Options{
-		Authenticator: authenticator,
-		URL:           logsEndpoint,
-	}
+		logsClientOptions := &logsv0.LogsV0Options{
+			Authenticator: authenticator,
+			URL:           logsEndpoint,
+		}
 
-	// Construct the service client.
-	session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.logsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.logsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
-	}
+		// Construct the service client.
+		session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.logsClient.Service)
+			// Add custom header for analytics
+			session.logsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
+		}
+	})
 
-	// LOGS ROUTER Version 0
-	var logsrouterClientURL string
-	var logsrouterURLErr error
+	session.lazy("ibmCloudLogsRoutingClient", func() {
+		var err error
+		// LOGS ROUTER Version 0
+		var logsrouterClientURL string
+		var logsrouterURLErr error
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion(c.Region)
+			}
+		} else {
 			logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion(c.Region)
 		}
-	} else {
-		logsrouterClientURL, logsrouterURLErr = ibmcloudlogsroutingv0.GetServiceURLForRegion(c.Region)
-	}
-	if logsrouterURLErr != nil {
-		logsrouterClientURL = ibmcloudlogsroutingv0.DefaultServiceURL
-	}
-	ibmCloudLogsRoutingClientOptions := &ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0Options{
-		Authenticator: authenticator,
-		URL:           logsrouterClientURL,
-	}
-
-	// Construct the service client.
-	session.ibmCloudLogsRoutingClient, err = ibmcloudlogsroutingv0.NewIBMCloudLogsRoutingV0(ibmCloudLogsRoutingClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.ibmCloudLogsRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
-	}
-
-	// Construct an "options" struct for creating the service client.
-	ukoClientOptions := &ukov4.UkoV4Options{
-		Authenticator: authenticator,
-	}
-
-	// Construct the service client.
-	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.ukoClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
-	}
-
-	// APP ID Service
-	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
-	if c.Visibility == "private" {
-		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
-	}
-	appIDClientOptions := &appid.AppIDManagementV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
-	}
-	appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
-	if err != nil {
-		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
-	}
-	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		appIDClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.appidAPI = appIDClient
+		if logsrouterURLErr != nil {
+			logsrouterClientURL = ibmcloudlogsroutingv0.DefaultServiceURL
+		}
+		ibmCloudLogsRoutingClientOptions := &ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0Options{
+			Authenticator: authenticator,
+			URL:           logsrouterClientURL,
+		}
 
-	// Construct an "options" struct for creating Context Based Restrictions service client.
-	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-de" {
-			cbrURL = ContructEndpoint(fmt.Sprintf("private.%s.cbr", c.Region), cloudEndpoint)
+		// Construct the service client.
+		session.ibmCloudLogsRoutingClient, err = ibmcloudlogsroutingv0.NewIBMCloudLogsRoutingV0(ibmCloudLogsRoutingClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.ibmCloudLogsRoutingClient.Service)
+			// Add custom header for analytics
+			session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
 		} else {
-			cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
+			session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
-	}
-	contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
-	}
+	})
 
-	// Construct the service client.
-	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
-	if err == nil && session.contextBasedRestrictionsClient != nil {
-		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
-	}
+	session.lazy("ukoClient", func() {
+		var err error
+		// Construct an "options" struct for creating the service client.
+		ukoClientOptions := &ukov4.UkoV4Options{
+			Authenticator: authenticator,
+		}
 
-	// PARTNER CENTER SELL (product lifecycle) service
-	partnerCenterSellURL := "https://product-lifecycle.api.cloud.ibm.com/openapi/v1"
-	if c.Visibility == "private" {
-		session.partnerCenterSellClientErr = fmt.Errorf("partner center sell does not support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		partnerCenterSellURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", c.Region, partnerCenterSellURL)
-	}
-	partnerCenterSellClientOptions := &partnercentersellv1.PartnerCenterSellV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT"}, partnerCenterSellURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.partnerCenterSellClient, err = partnercentersellv1.NewPartnerCenterSellV1(partnerCenterSellClientOptions)
-	if err != nil {
-		session.partnerCenterSellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Partner Center Sell API service: %q", err)
-	}
-	if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
+		// Construct the service client.
+		session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.ukoClient.Service)
+			// Add custom header for analytics
+			session.ukoClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
+		}
+	})
+
+	session.lazy("appidAPI", func() {
+		// APP ID Service
+		appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
+		if c.Visibility == "private" {
+			session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			appIDEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT", c.Region, appIDEndpoint)
+		}
+		appIDClientOptions := &appid.AppIDManagementV4Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT"}, appIDEndpoint),
+		}
+		appIDClient, err := appid.NewAppIDManagementV4(appIDClientOptions)
+		if err != nil {
+			session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
+		}
+		if appIDClient != nil && appIDClient.Service != nil {
+			c.EnableRetries(appIDClient.Service)
+			appIDClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.appidAPI = appIDClient
+	})
+
+	session.lazy("contextBasedRestrictionsClient", func() {
+		var err error
+		// Construct an "options" struct for creating Context Based Restrictions service client.
+		cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-de" {
+				cbrURL = ContructEndpoint(fmt.Sprintf("private.%s.cbr", c.Region), cloudEndpoint)
+			} else {
+				cbrURL = ContructEndpoint("private.cbr", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cbrURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT", c.Region, cbrURL)
+		}
+		contextBasedRestrictionsClientOptions := &contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+		}
+
+		// Construct the service client.
+		session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
+		if err == nil && session.contextBasedRestrictionsClient != nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.contextBasedRestrictionsClient.Service)
+			// Add custom header for analytics
+			session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
+		}
+	})
+
+	session.lazy("partnerCenterSellClient", func() {
+		var err error
+		// PARTNER CENTER SELL (product lifecycle) service
+		partnerCenterSellURL := "https://product-lifecycle.api.cloud.ibm.com/openapi/v1"
+		if c.Visibility == "private" {
+			session.partnerCenterSellClientErr = fmt.Errorf("partner center sell does not support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			partnerCenterSellURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT", c.Region, partnerCenterSellURL)
+		}
+		partnerCenterSellClientOptions := &partnercentersellv1.PartnerCenterSellV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT"}, partnerCenterSellURL),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.partnerCenterSellClient, err = partnercentersellv1.NewPartnerCenterSellV1(partnerCenterSellClientOptions)
+		if err != nil {
+			session.partnerCenterSellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Partner Center Sell API service: %q", err)
+		}
+		if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.partnerCenterSellClient.Service)
+			// Add custom header for analytics
+			session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("usageReportsClient", func() {
+		//Usage Reports Service Client
+		usageReportsURL := usagereportsv4.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				usageReportsURL = ContructEndpoint(fmt.Sprintf("private.%s.usagereports", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				usageReportsURL = ContructEndpoint("private.us-south.usagereports", fmt.Sprintf("%s/v1", cloudEndpoint))
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				usageReportsURL = ContructEndpoint(fmt.Sprintf("private.%s.usagereports", c.Region),
+					fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				usageReportsURL = usagereportsv4.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
+		}
+		usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
+		}
+		usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
+		if err != nil {
+			session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
+		}
+		if usageReportsClient != nil && usageReportsClient.Service != nil {
+			c.EnableRetries(usageReportsClient.Service)
+			usageReportsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.usageReportsClient = usageReportsClient
+	})
+
+	session.lazy("catalogManagementClient", func() {
+		var err error
+		// CATALOG MANAGEMENT Service
+		catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
+		if c.Visibility == "private" {
+			session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
+		}
+		catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
+			Authenticator: authenticator,
+		}
+		// Construct the service client.
+		session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
+		if err != nil {
+			session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
+		}
+		if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.catalogManagementClient.Service)
+			// Add custom header for analytics
+			session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("atrackerClientV2", func() {
+		var err error
+		// ATRACKER Version 2
+		var atrackerClientV2URL string
+		var atrackerURLV2Err error
+
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
+			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
+		}
+		if atrackerURLV2Err != nil {
+			atrackerClientV2URL = atrackerv2.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
+		}
+		atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
+		}
+		// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
+		// This should technically never happen as we default this for v2
+		if atrackerURLV2Err != nil && len(atrackerClientV2Options.URL) == 0 {
+			session.atrackerClientV2Err = atrackerURLV2Err
+		}
+		session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.atrackerClientV2.Service)
+			// Add custom header for analytics
+			session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
+		}
+	})
+
+	session.lazy("metricsRouterClient", func() {
+		var err error
+		// Construct an "options" struct for creating the service client for Metrics Router
+		var metricsRouterClientURL string
+		var metricsRouterURLV3Err error
+
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion("private." + c.Region)
+			if metricsRouterURLV3Err != nil && c.Visibility == "public-and-private" {
+				metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion(c.Region)
+			}
+		} else {
+			metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion(c.Region)
+		}
+		if metricsRouterURLV3Err != nil {
+			metricsRouterClientURL = metricsrouterv3.DefaultServiceURL
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
+		}
+		metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
+		}
+
+		// Construct the service client.
+		session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.metricsRouterClient.Service)
+			// Add custom header for analytics
+			session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
+		}
+	})
+
+	session.lazy("securityAndComplianceCenterClient", func() {
+		var err error
+		// SCC (Security and Compliance Center) Service
+		sccApiClientURL := scc.DefaultServiceURL
+		// Construct the service options.
+		if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
+			sccApiClientURL = regionURL
+		}
+		sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
+		}
+
+		// Construct the service client.
+		session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterApiV3(sccApiClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.securityAndComplianceCenterClient.Service)
+			// Add custom header for analytics
+			session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
+		}
+	})
+
+	session.lazy("schematicsClient", func() {
+		// SCHEMATICS Service
+		// schematicsEndpoint := "https://schematics.cloud.ibm.com"
+		schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
+		}
+		schematicsClientOptions := &schematicsv1.SchematicsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
+		}
+		// Construct the service client.
+		schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
+		if err != nil {
+			session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
+		}
 		// Enable retries for API calls
-		session.partnerCenterSellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
+		if schematicsClient != nil && schematicsClient.Service != nil {
+			c.EnableRetries(schematicsClient.Service)
+			schematicsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.schematicsClient = schematicsClient
+	})
+
+	session.lazy("vpcAPI", func() {
+		// VPC Service
+		vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
+		}
+		vpcoptions := &vpc.VpcV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcclient, err := vpc.NewVpcV1(vpcoptions)
+		if err != nil {
+			session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
+		}
+		if vpcclient != nil && vpcclient.Service != nil {
+			c.EnableRetries(vpcclient.Service)
+			session.rateLimit(RateLimitVPC, vpcclient.Service)
+			vpcclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.vpcAPI = vpcclient
+
+		vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
+			Authenticator: authenticator,
+		}
+		vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
+		if err != nil {
+			session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
+		}
+		if vpcbetaclient != nil && vpcbetaclient.Service != nil {
+			c.EnableRetries(vpcbetaclient.Service)
+			session.rateLimit(RateLimitVPC, vpcbetaclient.Service)
+			vpcbetaclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.vpcBetaAPI = vpcbetaclient
+	})
+
+	session.lazy("pushServiceClient", func() {
+		// PUSH NOTIFICATIONS Service
+		pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
+		if c.Visibility == "private" {
+			session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
+		}
+		pushNotificationOptions := &pushservicev1.PushServiceV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
+			Authenticator: authenticator,
+		}
+		pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
+		if err != nil {
+			session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
+		}
+		if pnclient != nil && pnclient.Service != nil {
+			// Enable retries for API calls
+			c.EnableRetries(pnclient.Service)
+			pnclient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.pushServiceClient = pnclient
+	})
+
+	session.lazy("eventNotificationsApiClient", func() {
+		var err error
+		// event notifications
+		enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
+
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			enurl = fmt.Sprintf("https://private.%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
+		}
+
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
+		}
+		enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
+		}
+		// Construct the service client.
+		session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
+		if err != nil {
+			// Enable {
+			session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
+		}
+		if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.eventNotificationsApiClient.Service)
+			session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("appConfigurationClient", func() {
+		// APP CONFIGURATION Service
+		appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
+		}
+		appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
+			Authenticator: authenticator,
+		}
+
+		appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
+		if appConfigClient != nil {
+			// Enable retries for API calls
+			c.EnableRetries(appConfigClient.Service)
+			session.appConfigurationClient = appConfigClient
+		} else {
+			session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
+		}
+	})
+
+	session.lazy("containerRegistryClient", func() {
+		session.load(bluemixAuthKey)
+		// CONTAINER REGISTRY Service
+		// Construct an "options" struct for creating the service client.
+		containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
+		if err != nil {
+			containerRegistryClientURL = containerregistryv1.DefaultServiceURL
+		}
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			containerRegistryClientURL, err = GetPrivateServiceURLForRegion(c.Region)
+			if err != nil {
+				containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
+		}
+		containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+			Account:       core.StringPtr(session.bmxUserDetails.UserAccount),
+		}
+		// Construct the service client.
+		session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
+		if err != nil {
+			session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
+		}
+		if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.containerRegistryClient.Service)
+			// Add custom header for analytics
+			session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("cosConfigAPI", func() {
+		// OBJECT STORAGE Service
+		cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
+		}
+		cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
+		}
+		cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
+		if err != nil {
+			session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
+		} else {
+			// Enable retries for API calls
+			c.EnableRetries(cosconfigclient.Service)
+		}
+		session.cosConfigAPI = cosconfigclient
+	})
+
+	session.lazy("globalSearchServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
+		if err != nil {
+			session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		session.globalSearchServiceAPI = globalSearchAPI
+		// Global Tagging Bluemix-go
+		globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
+		if err != nil {
+			session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		session.globalTaggingServiceAPI = globalTaggingAPI
+	})
+
+	session.lazy("globalTaggingServiceAPIV1", func() {
+		// GLOBAL TAGGING Service
+		globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			var globalTaggingRegion string
+			if c.Region != "us-south" && c.Region != "us-east" {
+				globalTaggingRegion = "us-south"
+			} else {
+				globalTaggingRegion = c.Region
+			}
+			globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
+		}
+		globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
+			Authenticator: authenticator,
+		}
+		globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
+		if err != nil {
+			session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
+		}
+		if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
+			session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
+			c.EnableRetries(session.globalTaggingServiceAPIV1.Service)
+			session.rateLimit(RateLimitGlobalTagging, session.globalTaggingServiceAPIV1.Service)
+			session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		// GLOBAL TAGGING Service
+		globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			var globalSearchRegion string
+			if c.Region != "us-south" && c.Region != "au-syd" && c.Region != "eu-gb" {
+				globalSearchRegion = "us-south"
+			} else {
+				globalSearchRegion = c.Region
+			}
+			globalSearchEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s", globalSearchRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
+		}
+		globalSearchV2Options := &searchv2.GlobalSearchV2Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
+			Authenticator: authenticator,
+		}
+		globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
+		if err != nil {
+			session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		}
+		if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
+			session.globalSearchServiceAPIV2 = *globalSearchAPIV2
+			c.EnableRetries(session.globalSearchServiceAPIV2.Service)
+			session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("icdServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		icdAPI, err := icdv4.New(sess.BluemixSession)
+		if err != nil {
+			session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
+		}
+		session.icdServiceAPI = icdAPI
+	})
+
+	session.lazy("cloudDatabasesClient", func() {
+		var err error
+		var cloudDatabasesEndpoint string
+
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
+		} else {
+			cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
+		}
+
+		// Construct an "options" struct for creating the service client.
+		cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
+			Authenticator: authenticator,
+		}
+
+		// Construct the service client.
+		session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.cloudDatabasesClient.Service)
+			// Add custom header for analytics
+			session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
+		}
+	})
+
+	session.lazy("resourceCatalogServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
+		}
+		session.resourceCatalogServiceAPI = resourceCatalogAPI
+	})
+
+	session.lazy("resourceManagementServiceAPIv2", func() {
+		session.load(bluemixAuthKey)
+		resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
+		}
+		session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+	})
+
+	session.lazy("resourceControllerServiceAPI", func() {
+		session.load(bluemixAuthKey)
+		resourceControllerAPI, err := controller.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		session.resourceControllerServiceAPI = resourceControllerAPI
+	})
+
+	session.lazy("resourceControllerServiceAPIv2", func() {
+		session.load(bluemixAuthKey)
+		ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
+		if err != nil {
+			session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
+		}
+		session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+	})
+
+	session.lazy("userManagementAPI", func() {
+		session.load(bluemixAuthKey)
+		userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
+		if err != nil {
+			session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
+		}
+		session.userManagementAPI = userManagementAPI
+	})
+
+	session.lazy("functionIAMNamespaceAPI", func() {
+		session.load(bluemixAuthKey)
+		namespaceFunction, err := functions.New(sess.BluemixSession)
+		if err != nil {
+			session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
+		}
+		session.functionIAMNamespaceAPI = namespaceFunction
+	})
+
+	session.lazy("apigatewayAPI", func() {
+		//  API GATEWAY service
+		apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
+		}
+		APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
+			Authenticator: &core.NoAuthAuthenticator{},
+		}
+		apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
+		if err != nil {
+			session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
+		}
+		session.apigatewayAPI = apigatewayAPI
+	})
+
+	session.lazy("ibmpiSession", func() {
+		session.load(bluemixAuthKey)
+		// POWER SYSTEMS Service
+		piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
+		ibmPIOptions := &ibmpisession.IBMPIOptions{
+			Authenticator: authenticator,
+			Debug:         os.Getenv("TF_LOG") != "",
+			Region:        c.Region,
+			URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
+			UserAccount:   session.bmxUserDetails.UserAccount,
+			Zone:          c.Zone,
+		}
+		ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
+		if err != nil {
+			session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+		}
+		if ibmpisession != nil {
+			c.recordRuntime(ibmpisession.Power.Transport)
+			session.rateLimitRuntime(RateLimitPower, ibmpisession.Power.Transport)
+		}
+		session.ibmpiSession = ibmpisession
+	})
+
+	session.lazy("pDNSClient", func() {
+		// PRIVATE DNS Service
+		pdnsURL := dns.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
+		}
+		dnsOptions := &dns.DnsSvcsV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
+			Authenticator: authenticator,
+		}
+		session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
+		if session.pDNSErr != nil {
+			session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
+		}
+		if session.pDNSClient != nil && session.pDNSClient.Service != nil {
+			c.EnableRetries(session.pDNSClient.Service)
+			session.pDNSClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("directlinkAPI", func() {
+		// DIRECT LINK Service
+		ver := time.Now().Format("2006-01-02")
+		dlURL := dl.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
+		}
+		directlinkOptions := &dl.DirectLinkV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
+		if session.directlinkErr != nil {
+			session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
+		}
+		if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
+			c.EnableRetries(session.directlinkAPI.Service)
+			session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+
+		// DIRECT LINK PROVIDER Service
+		dlproviderURL := dlProviderV2.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
+		}
+		directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
+			Authenticator: authenticator,
+			Version:       &ver,
+		}
+		session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
+		if session.dlProviderErr != nil {
+			session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
+		}
+		if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
+			c.EnableRetries(session.dlProviderAPI.Service)
+			session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("transitgatewayAPI", func() {
+		// TRANSIT GATEWAY Service
+		tgURL := tg.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
+		}
+		transitgatewayOptions := &tg.TransitGatewayApisV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
+		if session.transitgatewayErr != nil {
+			session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
+		}
+		if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
+			c.EnableRetries(session.transitgatewayAPI.Service)
+			// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
+			// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			// })
+		}
+	})
+
+	session.lazy("configurationAggregatorClient", func() {
+		var err error
+		// Construct an instance of the 'Configuration Aggregator' service.
+		var configBaseURL string
+		configBaseURL = ContructEndpoint(fmt.Sprintf("%s.apprapp", c.Region), cloudEndpoint)
+
+		configurationAggregatorClientOptions := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			Authenticator: authenticator,
+			URL:           configBaseURL,
+		}
+
+		// Construct the service client.
+		session.configurationAggregatorClient, err = configurationaggregatorv1.NewConfigurationAggregatorV1(configurationAggregatorClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.configurationAggregatorClient.Service)
+			// Add custom header for analytics
+			session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
+		}
+	})
+
+	session.lazy("db2saasClient", func() {
+		var err error
+		// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
+		if session.db2saasClientErr == nil {
+			// Construct the service options.
+			defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
+			db2saasClientOptions := &db2saasv1.Db2saasV1Options{
+				URL:           EnvFallBack([]string{"IBMCLOUD_DB2_API_ENDPOINT"}, defaultServiceEndpoint),
+				Authenticator: authenticator,
+			}
+
+			// Construct the service client.
+			session.db2saasClient, err = db2saasv1.NewDb2saasV1(db2saasClientOptions)
+			if err == nil {
+				// Enable retries for API calls
+				c.EnableRetries(session.db2saasClient.Service)
+				// Add custom header for analytics
+				session.db2saasClient.SetDefaultHeaders(gohttp.Header{
+					"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+				})
+			} else {
+				session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
+			}
+		}
+	})
+
+	// CIS Service instances starts here.
+	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
+	if c.Visibility == "private" {
+		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
+		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
 	}
+	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+
+	session.lazy("cisZonesV1Client", func() {
+		// IBM Network CIS Zones service
+		cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
+		if session.cisZonesErr != nil {
+			session.cisZonesErr = fmt.Errorf(
+				"Error occured while configuring CIS Zones service: %s",
+				session.cisZonesErr)
+		}
+		if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
+			c.EnableRetries(session.cisZonesV1Client.Service)
+			session.rateLimit(RateLimitCIS, session.cisZonesV1Client.Service)
+			session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("cisDNSRecordsClient", func() {
+		// IBM Network CIS DNS Record service
+		cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
+		if session.cisDNSErr != nil {
+			session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
+		}
+		if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
+			c.EnableRetries(session.cisDNSRecordsClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisDNSRecordsClient.Service)
+			session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("cisDNSRecordBulkClient", func() {
+		// IBM Network CIS DNS Record bulk service
+		cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
+		if session.cisDNSBulkErr != nil {
+			session.cisDNSBulkErr = fmt.Errorf(
+				"Error occured while configuration CIS DNS bulk service : %s",
+				session.cisDNSBulkErr)
+		}
+		if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
+			c.EnableRetries(session.cisDNSRecordBulkClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisDNSRecordBulkClient.Service)
+			session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("cisGLBPoolClient", func() {
+		// IBM Network CIS Global load balancer pool
+		cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisGLBPoolClient, session.cisGLBPoolErr = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
+		if session.cisGLBPoolErr != nil {
+			session.cisGLBPoolErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
+				session.cisGLBPoolErr)
+		}
+		if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
+			c.EnableRetries(session.cisGLBPoolClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisGLBPoolClient.Service)
+			session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	//Usage Reports Service Client
-	usageReportsURL := usagereportsv4.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			usageReportsURL = ContructEndpoint(fmt.Sprintf("private.%s.usagereports", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			usageReportsURL = ContructEndpoint("private.us-south.usagereports", fmt.Sprintf("%s/v1", cloudEndpoint))
+	session.lazy("cisGLBClient", func() {
+		// IBM Network CIS Global load balancer
+		cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			usageReportsURL = ContructEndpoint(fmt.Sprintf("private.%s.usagereports", c.Region),
-				fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			usageReportsURL = usagereportsv4.DefaultServiceURL
+		session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
+		if session.cisGLBErr != nil {
+			session.cisGLBErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
+				session.cisGLBErr)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
-	}
-	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
-	}
-	usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
-	if err != nil {
-		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
-	}
-	if usageReportsClient != nil && usageReportsClient.Service != nil {
-		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		usageReportsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.usageReportsClient = usageReportsClient
+		if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
+			c.EnableRetries(session.cisGLBClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisGLBClient.Service)
+			session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// CATALOG MANAGEMENT Service
-	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
-	if c.Visibility == "private" {
-		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		catalogManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT", c.Region, catalogManagementURL)
-	}
-	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
-	}
-	// Construct the service client.
-	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
-	if err != nil {
-		session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
-	}
-	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
-		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("cisGLBHealthCheckClient", func() {
+		// IBM Network CIS Global load balancer health check/monitor
+		cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
+		if session.cisGLBHealthCheckErr != nil {
+			session.cisGLBHealthCheckErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
+				session.cisGLBHealthCheckErr)
+		}
+		if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
+			c.EnableRetries(session.cisGLBHealthCheckClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisGLBHealthCheckClient.Service)
+			session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// ATRACKER Version 2
-	var atrackerClientV2URL string
-	var atrackerURLV2Err error
+	session.lazy("cisIPClient", func() {
+		// IBM Network CIS IP
+		cisIPOpt := &cisipv1.CisIpApiV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
+		if session.cisIPErr != nil {
+			session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
+				session.cisIPErr)
+		}
+		if session.cisIPClient != nil && session.cisIPClient.Service != nil {
+			c.EnableRetries(session.cisIPClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisIPClient.Service)
+			session.cisIPClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
+	session.lazy("cisRLClient", func() {
+		// IBM Network CIS Zone Rate Limit
+		cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
 		}
-	} else {
-		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
-	}
-	if atrackerURLV2Err != nil {
-		atrackerClientV2URL = atrackerv2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		atrackerClientV2URL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ATRACKER_API_ENDPOINT", c.Region, atrackerClientV2URL)
-	}
-	atrackerClientV2Options := &atrackerv2.AtrackerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientV2URL),
-	}
-	// If we provide IBMCLOUD_ATRACKER_API_ENDPOINT, then ignore any missing region url, or should use the default.
-	// This should technically never happen as we default this for v2
-	if atrackerURLV2Err != nil && len(atrackerClientV2Options.URL) == 0 {
-		session.atrackerClientV2Err = atrackerURLV2Err
-	}
-	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
-	if err == nil {
-		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
-	}
+		session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
+		if session.cisRLErr != nil {
+			session.cisRLErr = fmt.Errorf(
+				"Error occured while cofiguring CIS Zone Rate Limit service: %s",
+				session.cisRLErr)
+		}
+		if session.cisRLClient != nil && session.cisRLClient.Service != nil {
+			c.EnableRetries(session.cisRLClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisRLClient.Service)
+			session.cisRLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		// IBM Network CIS Alerts
+		cisAlertsOpt := &cisalertsv1.AlertsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
+		if session.cisAlertsErr != nil {
+			session.cisAlertsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
+				session.cisAlertsErr)
+		}
+		if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
+			c.EnableRetries(session.cisAlertsClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisAlertsClient.Service)
+			session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct an "options" struct for creating the service client for Metrics Router
-	var metricsRouterClientURL string
-	var metricsRouterURLV3Err error
+	session.lazy("cisRulesetsClient", func() {
+		// IBM Network CIS Rulesets
+		cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRulesetsClient, session.cisRulesetsErr = cisrulesetsv1.NewRulesetsV1(cisRulesetsOpt)
+		if session.cisRulesetsErr != nil {
+			session.cisRulesetsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Rulesets : %s",
+				session.cisRulesetsErr)
+		}
+		if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
+			c.EnableRetries(session.cisRulesetsClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisRulesetsClient.Service)
+			session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion("private." + c.Region)
-		if metricsRouterURLV3Err != nil && c.Visibility == "public-and-private" {
-			metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion(c.Region)
+	session.lazy("cisPageRuleClient", func() {
+		// IBM Network CIS Page Rules
+		cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
 		}
-	} else {
-		metricsRouterClientURL, metricsRouterURLV3Err = metricsrouterv3.GetServiceURLForRegion(c.Region)
-	}
-	if metricsRouterURLV3Err != nil {
-		metricsRouterClientURL = metricsrouterv3.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		metricsRouterClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_METRICS_ROUTING_API_ENDPOINT", c.Region, metricsRouterClientURL)
-	}
-	metricsRouterClientOptions := &metricsrouterv3.MetricsRouterV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT"}, metricsRouterClientURL),
-	}
+		session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
+		if session.cisPageRuleErr != nil {
+			session.cisPageRuleErr = fmt.Errorf(
+				"Error occured while cofiguring CIS Page Rule service: %s",
+				session.cisPageRuleErr)
+		}
+		if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
+			c.EnableRetries(session.cisPageRuleClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisPageRuleClient.Service)
+			session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct the service client.
-	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
-	}
+	session.lazy("cisEdgeFunctionClient", func() {
+		// IBM Network CIS Edge Function
+		cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisEdgeFunctionClient, session.cisEdgeFunctionErr = cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
+		if session.cisEdgeFunctionErr != nil {
+			session.cisEdgeFunctionErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
+				session.cisEdgeFunctionErr)
+		}
+		if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
+			c.EnableRetries(session.cisEdgeFunctionClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisEdgeFunctionClient.Service)
+			session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// SCC (Security and Compliance Center) Service
-	sccApiClientURL := scc.DefaultServiceURL
-	// Construct the service options.
-	if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
-		sccApiClientURL = regionURL
-	}
-	sccApiClientOptions := &scc.SecurityAndComplianceCenterApiV3Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCC_API_ENDPOINT"}, sccApiClientURL),
-	}
+	session.lazy("cisSSLClient", func() {
+		// IBM Network CIS SSL certificate
+		cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
 
-	// Construct the service client.
-	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterApiV3(sccApiClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
-	}
+		session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
+		if session.cisSSLErr != nil {
+			session.cisSSLErr = fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
+				session.cisSSLErr)
+		}
+		if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
+			c.EnableRetries(session.cisSSLClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisSSLClient.Service)
+			session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// SCHEMATICS Service
-	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
-	schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		schematicsEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SCHEMATICS_API_ENDPOINT", c.Region, schematicsEndpoint)
-	}
-	schematicsClientOptions := &schematicsv1.SchematicsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, schematicsEndpoint),
-	}
-	// Construct the service client.
-	schematicsClient, err := schematicsv1.NewSchematicsV1(schematicsClientOptions)
-	if err != nil {
-		session.schematicsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Schematics Service API service: %q", err)
-	}
-	// Enable retries for API calls
-	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		schematicsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.schematicsClient = schematicsClient
+	session.lazy("cisWAFPackageClient", func() {
+		// IBM Network CIS WAF Package
+		cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFPackageClient, session.cisWAFPackageErr = ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
+		if session.cisWAFPackageErr != nil {
+			session.cisWAFPackageErr = fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
+				session.cisWAFPackageErr)
+		}
+		if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
+			c.EnableRetries(session.cisWAFPackageClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisWAFPackageClient.Service)
+			session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// VPC Service
-	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		vpcurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", c.Region, vpcurl)
-	}
-	vpcoptions := &vpc.VpcV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcclient, err := vpc.NewVpcV1(vpcoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
-	}
-	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcAPI = vpcclient
+	session.lazy("cisDomainSettingsClient", func() {
+		// IBM Network CIS Domain settings
+		cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisDomainSettingsClient, session.cisDomainSettingsErr = cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
+		if session.cisDomainSettingsErr != nil {
+			session.cisDomainSettingsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
+				session.cisDomainSettingsErr)
+		}
+		if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
+			c.EnableRetries(session.cisDomainSettingsClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisDomainSettingsClient.Service)
+			session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcbetaclient, err := vpcbeta.NewVpcbetaV1(vpcbetaoptions)
-	if err != nil {
-		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
-	}
-	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
-		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.vpcBetaAPI = vpcbetaclient
+	session.lazy("cisRoutingClient", func() {
+		// IBM Network CIS Routing
+		cisRoutingOpt := &cisroutingv1.RoutingV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRoutingClient, session.cisRoutingErr = cisroutingv1.NewRoutingV1(cisRoutingOpt)
+		if session.cisRoutingErr != nil {
+			session.cisRoutingErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
+				session.cisRoutingErr)
+		}
+		if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
+			c.EnableRetries(session.cisRoutingClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisRoutingClient.Service)
+			session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// PUSH NOTIFICATIONS Service
-	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
-	if c.Visibility == "private" {
-		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pnurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PUSH_API_ENDPOINT", c.Region, pnurl)
-	}
-	pushNotificationOptions := &pushservicev1.PushServiceV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PUSH_API_ENDPOINT"}, pnurl),
-		Authenticator: authenticator,
-	}
-	pnclient, err := pushservicev1.NewPushServiceV1(pushNotificationOptions)
-	if err != nil {
-		session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
-	}
-	if pnclient != nil && pnclient.Service != nil {
-		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		pnclient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.pushServiceClient = pnclient
+	session.lazy("cisWAFGroupClient", func() {
+		// IBM Network CIS WAF Group
+		cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFGroupClient, session.cisWAFGroupErr = ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
+		if session.cisWAFGroupErr != nil {
+			session.cisWAFGroupErr = fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
+				session.cisWAFGroupErr)
+		}
+		if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
+			c.EnableRetries(session.cisWAFGroupClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisWAFGroupClient.Service)
+			session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// event notifications
-	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
+	session.lazy("cisCacheClient", func() {
+		// IBM Network CIS Cache service
+		cisCacheOpt := &ciscachev1.CachingApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisCacheClient, session.cisCacheErr = ciscachev1.NewCachingApiV1(cisCacheOpt)
+		if session.cisCacheErr != nil {
+			session.cisCacheErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
+				session.cisCacheErr)
+		}
+		if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
+			c.EnableRetries(session.cisCacheClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisCacheClient.Service)
+			session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		enurl = fmt.Sprintf("https://private.%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
-	}
+	session.lazy("cisCustomPageClient", func() {
+		// IBM Network CIS Custom pages service
+		cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
 
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT", c.Region, enurl)
-	}
-	enClientOptions := &eventnotificationsv1.EventNotificationsV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT"}, enurl),
-	}
-	// Construct the service client.
-	session.eventNotificationsApiClient, err = eventnotificationsv1.NewEventNotificationsV1(enClientOptions)
-	if err != nil {
-		// Enable {
-		session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
-	}
-	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
-		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		session.cisCustomPageClient, session.cisCustomPageErr = ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
+		if session.cisCustomPageErr != nil {
+			session.cisCustomPageErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
+				session.cisCustomPageErr)
+		}
+		if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
+			c.EnableRetries(session.cisCustomPageClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisCustomPageClient.Service)
+			session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// APP CONFIGURATION Service
-	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		appconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_APP_CONFIG_ENDPOINT", c.Region, appconfigurl)
-	}
-	appConfigurationClientOptions := &appconfigurationv1.AppConfigurationV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_APP_CONFIG_ENDPOINT"}, appconfigurl),
-		Authenticator: authenticator,
-	}
+	session.lazy("cisAccessRuleClient", func() {
+		// IBM Network CIS Firewall Access rule
+		cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisAccessRuleClient, session.cisAccessRuleErr = cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
+		if session.cisAccessRuleErr != nil {
+			session.cisAccessRuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
+				session.cisAccessRuleErr)
+		}
+		if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
+			c.EnableRetries(session.cisAccessRuleClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisAccessRuleClient.Service)
+			session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
-	if appConfigClient != nil {
-		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.appConfigurationClient = appConfigClient
-	} else {
-		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
-	}
+	session.lazy("cisUARuleClient", func() {
+		// IBM Network CIS Firewall User Agent Blocking rule
+		cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisUARuleClient, session.cisUARuleErr = cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
+		if session.cisUARuleErr != nil {
+			session.cisUARuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
+				session.cisUARuleErr)
+		}
+		if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
+			c.EnableRetries(session.cisUARuleClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisUARuleClient.Service)
+			session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// CONTAINER REGISTRY Service
-	// Construct an "options" struct for creating the service client.
-	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
-	if err != nil {
-		containerRegistryClientURL = containerregistryv1.DefaultServiceURL
-	}
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerRegistryClientURL, err = GetPrivateServiceURLForRegion(c.Region)
-		if err != nil {
-			containerRegistryClientURL, _ = GetPrivateServiceURLForRegion("global")
+	session.lazy("cisLockdownClient", func() {
+		// IBM Network CIS Firewall Lockdown rule
+		cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerRegistryClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CR_API_ENDPOINT", c.Region, containerRegistryClientURL)
-	}
-	containerRegistryClientOptions := &containerregistryv1.ContainerRegistryV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
-		Account:       core.StringPtr(userConfig.UserAccount),
-	}
-	// Construct the service client.
-	session.containerRegistryClient, err = containerregistryv1.NewContainerRegistryV1(containerRegistryClientOptions)
-	if err != nil {
-		session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
-	}
-	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
-		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		session.cisLockdownClient, session.cisLockdownErr = cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
+		if session.cisLockdownErr != nil {
+			session.cisLockdownErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
+				session.cisLockdownErr)
+		}
+		if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
+			c.EnableRetries(session.cisLockdownClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisLockdownClient.Service)
+			session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// OBJECT STORAGE Service
-	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
-	}
-	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, cosconfigurl),
-	}
-	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
-	if err != nil {
-		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
-	}
-	session.cosConfigAPI = cosconfigclient
+	session.lazy("cisRangeAppClient", func() {
+		// IBM Network CIS Range Application rule
+		cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisRangeAppClient, session.cisRangeAppErr = cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
+		if session.cisRangeAppErr != nil {
+			session.cisRangeAppErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
+				session.cisRangeAppErr)
+		}
+		if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
+			c.EnableRetries(session.cisRangeAppClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisRangeAppClient.Service)
+			session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
-	if err != nil {
-		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	session.globalSearchServiceAPI = globalSearchAPI
-	// Global Tagging Bluemix-go
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
-	if err != nil {
-		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	session.globalTaggingServiceAPI = globalTaggingAPI
+	session.lazy("cisWAFRuleClient", func() {
+		// IBM Network CIS WAF Rule Service
+		cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWAFRuleClient, session.cisWAFRuleErr = ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
+		if session.cisWAFRuleErr != nil {
+			session.cisWAFRuleErr = fmt.Errorf(
+				"Error occured while configuring CIS WAF Rules service: %s",
+				session.cisWAFRuleErr)
+		}
+		if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
+			c.EnableRetries(session.cisWAFRuleClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisWAFRuleClient.Service)
+			session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("cisLogpushJobsClient", func() {
+		// IBM Network CIS LogpushJobs
+		cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Dataset:       core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
+		if session.cisLogpushJobsErr != nil {
+			session.cisLogpushJobsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
+				session.cisLogpushJobsErr)
+		}
+		if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
+			c.EnableRetries(session.cisLogpushJobsClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisLogpushJobsClient.Service)
+			session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
+
+	session.lazy("cisMtlsClient", func() {
+		// IBM MTLS Session
+		cisMtlsOpt := &cismtlsv1.MtlsV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
+		if session.cisMtlsErr != nil {
+			session.cisMtlsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
+				session.cisMtlsErr)
+		}
+		if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
+			c.EnableRetries(session.cisMtlsClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisMtlsClient.Service)
+			session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// GLOBAL TAGGING Service
-	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		var globalTaggingRegion string
-		if c.Region != "us-south" && c.Region != "us-east" {
-			globalTaggingRegion = "us-south"
-		} else {
-			globalTaggingRegion = c.Region
+	session.lazy("cisBotManagementClient", func() {
+		// IBM Bot Management
+		cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
 		}
-		globalTaggingEndpoint = ContructEndpoint(fmt.Sprintf("tags.private.%s", globalTaggingRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalTaggingEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GT_API_ENDPOINT", c.Region, globalTaggingEndpoint)
-	}
-	globalTaggingV1Options := &globaltaggingv1.GlobalTaggingV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GT_API_ENDPOINT"}, globalTaggingEndpoint),
-		Authenticator: authenticator,
-	}
-	globalTaggingAPIV1, err := globaltaggingv1.NewGlobalTaggingV1(globalTaggingV1Options)
-	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
-	}
-	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
-		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// GLOBAL TAGGING Service
-	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		var globalSearchRegion string
-		if c.Region != "us-south" && c.Region != "au-syd" && c.Region != "eu-gb" {
-			globalSearchRegion = "us-south"
-		} else {
-			globalSearchRegion = c.Region
+		session.cisBotManagementClient, session.cisBotManagementErr = cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
+		if session.cisBotManagementErr != nil {
+			session.cisBotManagementErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Management : %s",
+				session.cisBotManagementErr)
 		}
-		globalSearchEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s", globalSearchRegion), fmt.Sprintf("global-search-tagging.%s", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		globalSearchEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_GS_API_ENDPOINT", c.Region, searchv2.DefaultServiceURL)
-	}
-	globalSearchV2Options := &searchv2.GlobalSearchV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_GS_API_ENDPOINT"}, globalSearchEndpoint),
-		Authenticator: authenticator,
-	}
-	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
-	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
-	}
-	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
-		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
+			c.EnableRetries(session.cisBotManagementClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisBotManagementClient.Service)
+			session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	icdAPI, err := icdv4.New(sess.BluemixSession)
-	if err != nil {
-		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
-	}
-	session.icdServiceAPI = icdAPI
+	session.lazy("cisBotAnalyticsClient", func() {
+		// IBM Bot Analytics
+		cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
+			URL:            cisEndPoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		session.cisBotAnalyticsClient, session.cisBotAnalyticsErr = cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
+		if session.cisBotAnalyticsErr != nil {
+			session.cisBotAnalyticsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Anaytics : %s",
+				session.cisBotAnalyticsErr)
+		}
+		if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
+			c.EnableRetries(session.cisBotAnalyticsClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisBotAnalyticsClient.Service)
+			session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	var cloudDatabasesEndpoint string
+	session.lazy("cisWebhooksClient", func() {
+		// IBM Network CIS Webhooks
+		cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
+			URL:           cisEndPoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
+		if session.cisWebhooksErr != nil {
+			session.cisWebhooksErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
+				session.cisWebhooksErr)
+		}
+		if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
+			c.EnableRetries(session.cisWebhooksClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisWebhooksClient.Service)
+			session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		// IBM Network CIS Filters
+		cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
+		if session.cisFiltersErr != nil {
+			session.cisFiltersErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
+				session.cisFiltersErr)
+		}
+		if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
+			c.EnableRetries(session.cisFiltersClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisFiltersClient.Service)
+			session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.private.databases.cloud.ibm.com/v5/ibm", c.Region)
-	} else {
-		cloudDatabasesEndpoint = fmt.Sprintf("https://api.%s.databases.cloud.ibm.com/v5/ibm", c.Region)
-	}
+	session.lazy("cisFirewallRulesClient", func() {
+		// IBM Network CIS Firewall rules
+		cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
+			URL:           cisEndPoint,
+			Authenticator: authenticator,
+		}
+		session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
+		if session.cisFirewallRulesErr != nil {
+			session.cisFirewallRulesErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
+				session.cisFirewallRulesErr)
+		}
+		if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
+			c.EnableRetries(session.cisFirewallRulesClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisFirewallRulesClient.Service)
+			session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct an "options" struct for creating the service client.
-	cloudDatabasesClientOptions := &clouddatabasesv5.CloudDatabasesV5Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DATABASES_API_ENDPOINT"}, cloudDatabasesEndpoint),
-		Authenticator: authenticator,
-	}
+	session.lazy("cisOriginAuthClient", func() {
+		// IBM Network CIS Authenticated Origin Pull
+		cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
+			URL:            cisEndPoint,
+			Authenticator:  authenticator,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+		}
 
-	// Construct the service client.
-	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
-	}
+		session.cisOriginAuthClient, session.cisOriginAuthPullErr = cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
+		if session.cisOriginAuthPullErr != nil {
+			session.cisOriginAuthPullErr = fmt.Errorf(
+				"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
+				session.cisOriginAuthPullErr)
+		}
+		if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
+			c.EnableRetries(session.cisOriginAuthClient.Service)
+			session.rateLimit(RateLimitCIS, session.cisOriginAuthClient.Service)
+			session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
-	}
-	session.resourceCatalogServiceAPI = resourceCatalogAPI
+	session.lazy("iamIdentityAPI", func() {
+		// IAM IDENTITY Service
+		// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+		iamIdenityURL := iamidentity.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
+		}
+		iamIdentityOptions := &iamidentity.IamIdentityV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
+		}
+		iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
+		if err != nil {
+			session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
+		}
+		if iamIdentityClient != nil && iamIdentityClient.Service != nil {
+			c.EnableRetries(iamIdentityClient.Service)
+			session.rateLimit(RateLimitIAM, iamIdentityClient.Service)
+			iamIdentityClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamIdentityAPI = iamIdentityClient
+	})
 
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+	session.lazy("iamPolicyManagementAPI", func() {
+		// IAM POLICY MANAGEMENT Service
+		iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamPolicyManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
+		}
+		iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
+		}
+		iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
+		if err != nil {
+			session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
+		}
+		if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
+			c.EnableRetries(iamPolicyManagementClient.Service)
+			session.rateLimit(RateLimitIAM, iamPolicyManagementClient.Service)
+			iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamPolicyManagementAPI = iamPolicyManagementClient
+	})
 
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	session.resourceControllerServiceAPI = resourceControllerAPI
+	session.lazy("iamAccessGroupsAPI", func() {
+		// IAM ACCESS GROUP
+		iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				iamAccessGroupsURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+			} else {
+				iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
+		}
+		iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
+		}
+		iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
+		if err != nil {
+			session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
+		}
+		if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
+			c.EnableRetries(iamAccessGroupsClient.Service)
+			session.rateLimit(RateLimitIAM, iamAccessGroupsClient.Service)
+			iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.iamAccessGroupsAPI = iamAccessGroupsClient
+	})
 
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
-	}
-	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+	session.lazy("resourceManagerAPI", func() {
+		// RESOURCE MANAGEMENT Service
+		rmURL := resourcemanager.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				rmURL = ContructEndpoint("private.us-south.resource-controller", fmt.Sprintf("%s", cloudEndpoint))
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+			} else {
+				rmURL = resourcemanager.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
+		}
+		resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
+		}
+		resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
+		if err != nil {
+			session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
+		}
+		if resourceManagerClient != nil && resourceManagerClient.Service != nil {
+			c.EnableRetries(resourceManagerClient.Service)
+			resourceManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceManagerAPI = resourceManagerClient
+	})
 
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
-	}
-	session.userManagementAPI = userManagementAPI
+	session.lazy("ibmCloudShellClient", func() {
+		var err error
+		// CLOUD SHELL Service
+		cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
+		}
+		ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
+		}
+		session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
+		if err != nil {
+			session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
+		}
+		if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
+			c.EnableRetries(session.ibmCloudShellClient.Service)
+			session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	namespaceFunction, err := functions.New(sess.BluemixSession)
-	if err != nil {
-		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
-	}
-	session.functionIAMNamespaceAPI = namespaceFunction
+	session.lazy("enterpriseManagementClient", func() {
+		// ENTERPRISE Service
+		enterpriseURL := enterprisemanagementv1.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
+				enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				enterpriseURL = ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
+				enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region),
+					fmt.Sprintf("%s/v1", cloudEndpoint))
+			} else {
+				enterpriseURL = enterprisemanagementv1.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
+		}
+		enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
+		}
+		enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
+		if err != nil {
+			session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
+		}
+		if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
+			c.EnableRetries(enterpriseManagementClient.Service)
+			enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.enterpriseManagementClient = enterpriseManagementClient
+	})
 
-	//  API GATEWAY service
-	apicurl := ContructEndpoint(fmt.Sprintf("api.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		apicurl = ContructEndpoint(fmt.Sprintf("api.private.%s.apigw", c.Region), fmt.Sprintf("%s/controller", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		apicurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_API_GATEWAY_ENDPOINT", c.Region, apicurl)
-	}
-	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
-		Authenticator: &core.NoAuthAuthenticator{},
-	}
-	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
-	if err != nil {
-		session.apigatewayErr = fmt.Errorf("[ERROR] Error occured while configuring  APIGateway service: %q", err)
-	}
-	session.apigatewayAPI = apigatewayAPI
+	session.lazy("resourceControllerAPI", func() {
+		// RESOURCE CONTROLLER Service
+		rcURL := resourcecontroller.DefaultServiceURL
+		if c.Visibility == "private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+			} else {
+				fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
+				rcURL = ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
+			}
+		}
+		if c.Visibility == "public-and-private" {
+			if c.Region == "us-south" || c.Region == "us-east" {
+				rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+			} else {
+				rcURL = resourcecontroller.DefaultServiceURL
+			}
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
+		}
+		resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
+		}
+		resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
+		if err != nil {
+			session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
+		}
+		if resourceControllerClient != nil && resourceControllerClient.Service != nil {
+			c.EnableRetries(resourceControllerClient.Service)
+			resourceControllerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+		session.resourceControllerAPI = resourceControllerClient
+	})
 
-	// POWER SYSTEMS Service
-	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
-	ibmPIOptions := &ibmpisession.IBMPIOptions{
-		Authenticator: authenticator,
-		Debug:         os.Getenv("TF_LOG") != "",
-		Region:        c.Region,
-		URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, piURL),
-		UserAccount:   userConfig.UserAccount,
-		Zone:          c.Zone,
-	}
-	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
-	if err != nil {
-		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
-	}
-	session.ibmpiSession = ibmpisession
+	session.lazy("secretsManagerClient", func() {
+		var err error
+		// SECRETS MANAGER Service V2
+		// Construct an "options" struct for creating the service client.
+		var smBaseUrl string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			smBaseUrl = ContructEndpoint(fmt.Sprintf("private.secrets-manager.%s", c.Region), cloudEndpoint)
+		} else {
+			smBaseUrl = ContructEndpoint(fmt.Sprintf("secrets-manager.%s", c.Region), cloudEndpoint)
+		}
 
-	// PRIVATE DNS Service
-	pdnsURL := dns.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		pdnsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PRIVATE_DNS_API_ENDPOINT", c.Region, pdnsURL)
-	}
-	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, pdnsURL),
-		Authenticator: authenticator,
-	}
-	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
-	if session.pDNSErr != nil {
-		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
-	}
-	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
+			Authenticator: authenticator,
+			URL:           smBaseUrl,
+		}
 
-	// DIRECT LINK Service
-	ver := time.Now().Format("2006-01-02")
-	dlURL := dl.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_API_ENDPOINT", c.Region, dlURL)
-	}
-	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, dlURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
-	if session.directlinkErr != nil {
-		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
-	}
-	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+		// Construct the service client.
+		session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.secretsManagerClient.Service)
+			// Add custom header for analytics
+			session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
+		}
+	})
 
-	// DIRECT LINK PROVIDER Service
-	dlproviderURL := dlProviderV2.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		dlproviderURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DL_PROVIDER_API_ENDPOINT", c.Region, dlproviderURL)
-	}
-	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, dlproviderURL),
-		Authenticator: authenticator,
-		Version:       &ver,
-	}
-	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
-	if session.dlProviderErr != nil {
-		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
-	}
-	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	session.lazy("satelliteClient", func() {
+		var err error
+		// SATELLITE Service
+		containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
+		}
+		kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
+			Authenticator: authenticator,
+		}
+		session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
+		if err != nil {
+			session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
+		}
 
-	// TRANSIT GATEWAY Service
-	tgURL := tg.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		tgURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TG_API_ENDPOINT", c.Region, tgURL)
-	}
-	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, tgURL),
-		Authenticator: authenticator,
-		Version:       CreateVersionDate(),
-	}
-	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
-	if session.transitgatewayErr != nil {
-		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
-	}
-	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
-		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		// })
-	}
+		// Enable retries for API calls
+		if session.satelliteClient != nil && session.satelliteClient.Service != nil {
+			c.EnableRetries(session.satelliteClient.Service)
+			session.satelliteClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct an instance of the 'Configuration Aggregator' service.
-	var configBaseURL string
-	configBaseURL = ContructEndpoint(fmt.Sprintf("%s.apprapp", c.Region), cloudEndpoint)
+	session.lazy("satelliteLinkClient", func() {
+		var err error
+		// SATELLITE LINK Service
+		// Construct an "options" struct for creating the service client.
+		satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
+		}
+		satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
+			Authenticator: authenticator,
+		}
+		session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
+		if err != nil {
+			session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
+		}
+		if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.satelliteLinkClient.Service)
+			// Add custom header for analytics
+			session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	configurationAggregatorClientOptions := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
-		Authenticator: authenticator,
-		URL:           configBaseURL,
-	}
+	session.lazy("esSchemaRegistryClient", func() {
+		var err error
+		esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
+			Authenticator: authenticator,
+		}
+		session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
+		if err != nil {
+			session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
+		}
+		if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
+			c.EnableRetries(session.esSchemaRegistryClient.Service)
+			session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct the service client.
-	session.configurationAggregatorClient, err = configurationaggregatorv1.NewConfigurationAggregatorV1(configurationAggregatorClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.configurationAggregatorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
-	}
+	session.lazy("esAdminRestClient", func() {
+		var err error
+		esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
+			Authenticator: authenticator,
+		}
+		session.esAdminRestClient, err = adminrestv1.NewAdminrestV1(esAdminRestV1Options)
+		if err != nil {
+			session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
+		}
+		if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
+			c.EnableRetries(session.esAdminRestClient.Service)
+			session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		}
+	})
 
-	// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
-	if session.db2saasClientErr == nil {
-		// Construct the service options.
-		defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
-		db2saasClientOptions := &db2saasv1.Db2saasV1Options{
-			URL:           EnvFallBack([]string{"IBMCLOUD_DB2_API_ENDPOINT"}, defaultServiceEndpoint),
+	session.lazy("cdToolchainClient", func() {
+		var err error
+		// Construct an "options" struct for creating the service client.
+		var cdToolchainClientURL string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
+			}
+		} else {
+			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
+		}
+		if err != nil {
+			session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
+		}
+		cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
 			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
 		}
 
 		// Construct the service client.
-		session.db2saasClient, err = db2saasv1.NewDb2saasV1(db2saasClientOptions)
+		session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.db2saasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			c.EnableRetries(session.cdToolchainClient.Service)
 			// Add custom header for analytics
-			session.db2saasClient.SetDefaultHeaders(gohttp.Header{
+			session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 			})
 		} else {
-			session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
+			session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 		}
-	}
-
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+	})
 
-	// IBM Network CIS Zones service
-	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
-	if session.cisZonesErr != nil {
-		session.cisZonesErr = fmt.Errorf(
-			"Error occured while configuring CIS Zones service: %s",
-			session.cisZonesErr)
-	}
-	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS DNS Record service
-	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
-	if session.cisDNSErr != nil {
-		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
-	}
-	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS DNS Record bulk service
-	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
-	if session.cisDNSBulkErr != nil {
-		session.cisDNSBulkErr = fmt.Errorf(
-			"Error occured while configuration CIS DNS bulk service : %s",
-			session.cisDNSBulkErr)
-	}
-	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Global load balancer pool
-	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBPoolClient, session.cisGLBPoolErr = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
-	if session.cisGLBPoolErr != nil {
-		session.cisGLBPoolErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Pool service: %s",
-			session.cisGLBPoolErr)
-	}
-	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Global load balancer
-	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-	session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
-	if session.cisGLBErr != nil {
-		session.cisGLBErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB service: %s",
-			session.cisGLBErr)
-	}
-	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Global load balancer health check/monitor
-	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
-	if session.cisGLBHealthCheckErr != nil {
-		session.cisGLBHealthCheckErr = fmt.Errorf("[ERROR] Error occured while configuring CIS GLB Health Check service: %s",
-			session.cisGLBHealthCheckErr)
-	}
-	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS IP
-	cisIPOpt := &cisipv1.CisIpApiV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
-	if session.cisIPErr != nil {
-		session.cisIPErr = fmt.Errorf("[ERROR] Error occured while configuring CIS IP service: %s",
-			session.cisIPErr)
-	}
-	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Zone Rate Limit
-	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
-	if session.cisRLErr != nil {
-		session.cisRLErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Zone Rate Limit service: %s",
-			session.cisRLErr)
-	}
-	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// IBM Network CIS Alerts
-	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisAlertsClient, session.cisAlertsErr = cisalertsv1.NewAlertsV1(cisAlertsOpt)
-	if session.cisAlertsErr != nil {
-		session.cisAlertsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Alerts : %s",
-			session.cisAlertsErr)
-	}
-	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Rulesets
-	cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRulesetsClient, session.cisRulesetsErr = cisrulesetsv1.NewRulesetsV1(cisRulesetsOpt)
-	if session.cisRulesetsErr != nil {
-		session.cisRulesetsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Rulesets : %s",
-			session.cisRulesetsErr)
-	}
-	if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
-		session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Page Rules
-	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
-	if session.cisPageRuleErr != nil {
-		session.cisPageRuleErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Page Rule service: %s",
-			session.cisPageRuleErr)
-	}
-	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Edge Function
-	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr = cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
-	if session.cisEdgeFunctionErr != nil {
-		session.cisEdgeFunctionErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Edge Function service: %s",
-			session.cisEdgeFunctionErr)
-	}
-	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS SSL certificate
-	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
-	if session.cisSSLErr != nil {
-		session.cisSSLErr = fmt.Errorf("[ERROR] Error occured while configuring CIS SSL certificate service: %s",
-			session.cisSSLErr)
-	}
-	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS WAF Package
-	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFPackageClient, session.cisWAFPackageErr = ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
-	if session.cisWAFPackageErr != nil {
-		session.cisWAFPackageErr = fmt.Errorf("[ERROR] Error occured while configuration CIS WAF Package service: %s",
-			session.cisWAFPackageErr)
-	}
-	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Domain settings
-	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDomainSettingsClient, session.cisDomainSettingsErr = cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
-	if session.cisDomainSettingsErr != nil {
-		session.cisDomainSettingsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Domain Settings service: %s",
-			session.cisDomainSettingsErr)
-	}
-	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Routing
-	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRoutingClient, session.cisRoutingErr = cisroutingv1.NewRoutingV1(cisRoutingOpt)
-	if session.cisRoutingErr != nil {
-		session.cisRoutingErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Routing service: %s",
-			session.cisRoutingErr)
-	}
-	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS WAF Group
-	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFGroupClient, session.cisWAFGroupErr = ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
-	if session.cisWAFGroupErr != nil {
-		session.cisWAFGroupErr = fmt.Errorf("[ERROR] Error occured while configuring CIS WAF Group service: %s",
-			session.cisWAFGroupErr)
-	}
-	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Cache service
-	cisCacheOpt := &ciscachev1.CachingApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisCacheClient, session.cisCacheErr = ciscachev1.NewCachingApiV1(cisCacheOpt)
-	if session.cisCacheErr != nil {
-		session.cisCacheErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Caching service: %s",
-			session.cisCacheErr)
-	}
-	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Custom pages service
-	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisCustomPageClient, session.cisCustomPageErr = ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
-	if session.cisCustomPageErr != nil {
-		session.cisCustomPageErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Custom Pages service: %s",
-			session.cisCustomPageErr)
-	}
-	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Firewall Access rule
-	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisAccessRuleClient, session.cisAccessRuleErr = cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
-	if session.cisAccessRuleErr != nil {
-		session.cisAccessRuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Access Rule service: %s",
-			session.cisAccessRuleErr)
-	}
-	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Firewall User Agent Blocking rule
-	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisUARuleClient, session.cisUARuleErr = cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
-	if session.cisUARuleErr != nil {
-		session.cisUARuleErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
-			session.cisUARuleErr)
-	}
-	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Firewall Lockdown rule
-	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisLockdownClient, session.cisLockdownErr = cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
-	if session.cisLockdownErr != nil {
-		session.cisLockdownErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall Lockdown Rule service: %s",
-			session.cisLockdownErr)
-	}
-	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Range Application rule
-	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRangeAppClient, session.cisRangeAppErr = cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
-	if session.cisRangeAppErr != nil {
-		session.cisRangeAppErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Range Application rule service: %s",
-			session.cisRangeAppErr)
-	}
-	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS WAF Rule Service
-	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFRuleClient, session.cisWAFRuleErr = ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
-	if session.cisWAFRuleErr != nil {
-		session.cisWAFRuleErr = fmt.Errorf(
-			"Error occured while configuring CIS WAF Rules service: %s",
-			session.cisWAFRuleErr)
-	}
-	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS LogpushJobs
-	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Dataset:       core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisLogpushJobsClient, session.cisLogpushJobsErr = cislogpushjobsapiv1.NewLogpushJobsApiV1(cisLogpushJobOpt)
-	if session.cisLogpushJobsErr != nil {
-		session.cisLogpushJobsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS LogpushJobs : %s",
-			session.cisLogpushJobsErr)
-	}
-	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM MTLS Session
-	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisMtlsClient, session.cisMtlsErr = cismtlsv1.NewMtlsV1(cisMtlsOpt)
-	if session.cisMtlsErr != nil {
-		session.cisMtlsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS MTLS : %s",
-			session.cisMtlsErr)
-	}
-	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Bot Management
-	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisBotManagementClient, session.cisBotManagementErr = cisbotmanagementv1.NewBotManagementV1(cisBotManagementOpt)
-	if session.cisBotManagementErr != nil {
-		session.cisBotManagementErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Management : %s",
-			session.cisBotManagementErr)
-	}
-	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
-		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Bot Analytics
-	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisBotAnalyticsClient, session.cisBotAnalyticsErr = cisbotanalyticsv1.NewBotAnalyticsV1(cisBotAnalyticsOpt)
-	if session.cisBotAnalyticsErr != nil {
-		session.cisBotAnalyticsErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Bot Anaytics : %s",
-			session.cisBotAnalyticsErr)
-	}
-	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
-		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Webhooks
-	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWebhooksClient, session.cisWebhooksErr = ciswebhooksv1.NewWebhooksV1(cisWebhooksOpt)
-	if session.cisWebhooksErr != nil {
-		session.cisWebhooksErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Webhooks : %s",
-			session.cisWebhooksErr)
-	}
-	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	// IBM Network CIS Filters
-	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFiltersClient, session.cisFiltersErr = cisfiltersv1.NewFiltersV1(cisFiltersOpt)
-	if session.cisFiltersErr != nil {
-		session.cisFiltersErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Filters : %s",
-			session.cisFiltersErr)
-	}
-	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Firewall rules
-	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisFirewallRulesClient, session.cisFirewallRulesErr = cisfirewallrulesv1.NewFirewallRulesV1(cisFirewallrulesOpt)
-	if session.cisFirewallRulesErr != nil {
-		session.cisFirewallRulesErr = fmt.Errorf("[ERROR] Error occured while configuring CIS Firewall rules : %s",
-			session.cisFirewallRulesErr)
-	}
-	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IBM Network CIS Authenticated Origin Pull
-	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-
-	session.cisOriginAuthClient, session.cisOriginAuthPullErr = cisoriginpull.NewAuthenticatedOriginPullApiV1(cisOriginAuthOptions)
-	if session.cisOriginAuthPullErr != nil {
-		session.cisOriginAuthPullErr = fmt.Errorf(
-			"Error occured while configuring CIS Authenticated Origin Pullservice: %s",
-			session.cisOriginAuthPullErr)
-	}
-	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// IAM IDENTITY Service
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
-	iamIdenityURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamIdenityURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+	session.lazy("cdTektonPipelineClient", func() {
+		var err error
+		// Construct an "options" struct for creating the tekton pipeline service client.
+		var cdTektonPipelineClientURL string
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
+			if err != nil && c.Visibility == "public-and-private" {
+				cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
+			}
 		} else {
-			iamIdenityURL = ContructEndpoint("private.iam", cloudEndpoint)
+			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamIdenityURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamIdenityURL)
-	}
-	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamIdenityURL),
-	}
-	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
-	if err != nil {
-		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
-	}
-	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamIdentityAPI = iamIdentityClient
-
-	// IAM POLICY MANAGEMENT Service
-	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamPolicyManagementURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamPolicyManagementURL = ContructEndpoint("private.iam", cloudEndpoint)
+		if err != nil {
+			cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamPolicyManagementURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamPolicyManagementURL)
-	}
-	iamPolicyManagementOptions := &iampolicymanagement.IamPolicyManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamPolicyManagementURL),
-	}
-	iamPolicyManagementClient, err := iampolicymanagement.NewIamPolicyManagementV1(iamPolicyManagementOptions)
-	if err != nil {
-		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
-	}
-	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamPolicyManagementAPI = iamPolicyManagementClient
-
-	// IAM ACCESS GROUP
-	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamAccessGroupsURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamAccessGroupsURL = ContructEndpoint("private.iam", cloudEndpoint)
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamAccessGroupsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamAccessGroupsURL)
-	}
-	iamAccessGroupsOptions := &iamaccessgroups.IamAccessGroupsV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamAccessGroupsURL),
-	}
-	iamAccessGroupsClient, err := iamaccessgroups.NewIamAccessGroupsV2(iamAccessGroupsOptions)
-	if err != nil {
-		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
-	}
-	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.iamAccessGroupsAPI = iamAccessGroupsClient
-
-	// RESOURCE MANAGEMENT Service
-	rmURL := resourcemanager.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rmURL = ContructEndpoint("private.us-south.resource-controller", fmt.Sprintf("%s", cloudEndpoint))
+		cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rmURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), fmt.Sprintf("%s", cloudEndpoint))
+		// Construct the service client.
+		session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.cdTektonPipelineClient.Service)
+			// Add custom header for analytics
+			session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
 		} else {
-			rmURL = resourcemanager.DefaultServiceURL
+			session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rmURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", c.Region, rmURL)
-	}
-	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, rmURL),
-	}
-	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
-	if err != nil {
-		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
-	}
-	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceManagerAPI = resourceManagerClient
-
-	// CLOUD SHELL Service
-	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
-	}
-	ibmCloudShellClientOptions := &ibmcloudshellv1.IBMCloudShellV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT"}, cloudShellUrl),
-	}
-	session.ibmCloudShellClient, err = ibmcloudshellv1.NewIBMCloudShellV1(ibmCloudShellClientOptions)
-	if err != nil {
-		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
-	}
-	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	})
 
-	// ENTERPRISE Service
-	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			enterpriseURL = ContructEndpoint("private.us-south.enterprise", fmt.Sprintf("%s/v1", cloudEndpoint))
+	session.lazy("mqcloudClient", func() {
+		var err error
+		// MQaaS Service Configuration
+		mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
-			enterpriseURL = ContructEndpoint(fmt.Sprintf("private.%s.enterprise", c.Region),
-				fmt.Sprintf("%s/v1", cloudEndpoint))
-		} else {
-			enterpriseURL = enterprisemanagementv1.DefaultServiceURL
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		enterpriseURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_API_ENDPOINT", c.Region, enterpriseURL)
-	}
-	enterpriseManagementClientOptions := &enterprisemanagementv1.EnterpriseManagementV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_API_ENDPOINT"}, enterpriseURL),
-	}
-	enterpriseManagementClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(enterpriseManagementClientOptions)
-	if err != nil {
-		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
-	}
-	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.enterpriseManagementClient = enterpriseManagementClient
-
-	// RESOURCE CONTROLLER Service
-	rcURL := resourcecontroller.DefaultServiceURL
-	if c.Visibility == "private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
-		} else {
-			fmt.Println("Private Endpint supports only us-south and us-east region specific endpoint")
-			rcURL = ContructEndpoint("private.us-south.resource-controller", cloudEndpoint)
+		accept_language := os.Getenv("IBMCLOUD_MQCLOUD_ACCEPT_LANGUAGE")
+		mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
+			Authenticator:  authenticator,
+			AcceptLanguage: core.StringPtr(accept_language),
+			URL:            EnvFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
 		}
-	}
-	if c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			rcURL = ContructEndpoint(fmt.Sprintf("private.%s.resource-controller", c.Region), cloudEndpoint)
+
+		// Construct the service client for MQaaS.
+		session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.mqcloudClient.Service)
+			// Add custom header for analytics
+			session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
 		} else {
-			rcURL = resourcecontroller.DefaultServiceURL
+			session.mqcloudClientErr = fmt.Errorf("Error occurred while configuringMQaaS service: %q", err)
 		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		rcURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", c.Region, rcURL)
-	}
-	resourceControllerOptions := &resourcecontroller.ResourceControllerV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, rcURL),
-	}
-	resourceControllerClient, err := resourcecontroller.NewResourceControllerV2(resourceControllerOptions)
-	if err != nil {
-		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
-	}
-	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-	session.resourceControllerAPI = resourceControllerClient
-
-	// SECRETS MANAGER Service V2
-	// Construct an "options" struct for creating the service client.
-	var smBaseUrl string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		smBaseUrl = ContructEndpoint(fmt.Sprintf("private.secrets-manager.%s", c.Region), cloudEndpoint)
-	} else {
-		smBaseUrl = ContructEndpoint(fmt.Sprintf("secrets-manager.%s", c.Region), cloudEndpoint)
-	}
-
-	secretsManagerClientOptionsV2 := &secretsmanagerv2.SecretsManagerV2Options{
-		Authenticator: authenticator,
-		URL:           smBaseUrl,
-	}
-
-	// Construct the service client.
-	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
-	if err == nil {
-		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
-	}
-
-	// SATELLITE Service
-	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		containerEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_API_ENDPOINT", c.Region, containerEndpoint)
-	}
-	kubernetesServiceV1Options := &kubernetesserviceapiv1.KubernetesServiceApiV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_API_ENDPOINT"}, containerEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteClient, err = kubernetesserviceapiv1.NewKubernetesServiceApiV1(kubernetesServiceV1Options)
-	if err != nil {
-		session.satelliteClientErr = fmt.Errorf("[ERROR] Error occured while configuring satellite client: %q", err)
-	}
-
-	// Enable retries for API calls
-	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	// SATELLITE LINK Service
-	// Construct an "options" struct for creating the service client.
-	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		satelliteLinkEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_SATELLITE_LINK_API_ENDPOINT", c.Region, satelliteLinkEndpoint)
-	}
-	satelliteLinkClientOptions := &satellitelinkv1.SatelliteLinkV1Options{
-		URL:           EnvFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkEndpoint),
-		Authenticator: authenticator,
-	}
-	session.satelliteLinkClient, err = satellitelinkv1.NewSatelliteLinkV1(satelliteLinkClientOptions)
-	if err != nil {
-		session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
-	}
-	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
-		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
-		Authenticator: authenticator,
-	}
-	session.esSchemaRegistryClient, err = schemaregistryv1.NewSchemaregistryV1(esSchemaRegistryV1Options)
-	if err != nil {
-		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
-	}
-	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
-
-	esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
-		Authenticator: authenticator,
-	}
-	session.esAdminRestClient, err = adminrestv1.NewAdminrestV1(esAdminRestV1Options)
-	if err != nil {
-		session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
-	}
-	if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
-		session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	}
+	})
 
-	// Construct an "options" struct for creating the service client.
-	var cdToolchainClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
+	session.lazy("vmwareClient", func() {
+		var err error
+		// VMware as a Service
+		// Construct the service options.
+		vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
+		vmwareClientOptions := &vmwarev1.VmwareV1Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"VMWARE_URL"}, vmwareURL),
 		}
-	} else {
-		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdToolchainClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TOOLCHAIN_ENDPOINT", c.Region, cdToolchainClientURL)
-	}
-	cdToolchainClientOptions := &cdtoolchainv2.CdToolchainV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_ENDPOINT"}, cdToolchainClientURL),
-	}
-
-	// Construct the service client.
-	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
-	}
 
-	// Construct an "options" struct for creating the tekton pipeline service client.
-	var cdTektonPipelineClientURL string
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
-			cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
+		// Construct the service client.
+		session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.vmwareClient.Service)
+			// Add custom header for analytics
+			session.vmwareClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.vmwareClientErr = fmt.Errorf("Error occurred while configuring VMware as a Service API service: %q", err)
 		}
-	} else {
-		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion(c.Region)
-	}
-	if err != nil {
-		cdTektonPipelineClientURL = cdtektonpipelinev2.DefaultServiceURL
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cdTektonPipelineClientURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_TEKTON_PIPELINE_ENDPOINT", c.Region, cdTektonPipelineClientURL)
-	}
-	cdTektonPipelineClientOptions := &cdtektonpipelinev2.CdTektonPipelineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT"}, cdTektonPipelineClientURL),
-	}
-	// Construct the service client.
-	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
-	}
-
-	// MQaaS Service Configuration
-	mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		mqCloudURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT", c.Region, mqCloudURL)
-	}
-	accept_language := os.Getenv("IBMCLOUD_MQCLOUD_ACCEPT_LANGUAGE")
-	mqcloudClientOptions := &mqcloudv1.MqcloudV1Options{
-		Authenticator:  authenticator,
-		AcceptLanguage: core.StringPtr(accept_language),
-		URL:            EnvFallBack([]string{"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT"}, mqCloudURL),
-	}
-
-	// Construct the service client for MQaaS.
-	session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.mqcloudClientErr = fmt.Errorf("Error occurred while configuringMQaaS service: %q", err)
-	}
-
-	// VMware as a Service
-	// Construct the service options.
-	vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
-	vmwareClientOptions := &vmwarev1.VmwareV1Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"VMWARE_URL"}, vmwareURL),
-	}
-
-	// Construct the service client.
-	session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.vmwareClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.vmwareClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.vmwareClientErr = fmt.Errorf("Error occurred while configuring VMware as a Service API service: %q", err)
-	}
+	})
 
-	// Construct the service options.
-	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
-	}
-	codeEngineClientOptions := &codeengine.CodeEngineV2Options{
-		Authenticator: authenticator,
-		URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
-	}
+	session.lazy("codeEngineClient", func() {
+		var err error
+		// Construct the service options.
+		codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			codeEngineEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CODE_ENGINE_API_ENDPOINT", c.Region, codeEngineEndpoint)
+		}
+		codeEngineClientOptions := &codeengine.CodeEngineV2Options{
+			Authenticator: authenticator,
+			URL:           EnvFallBack([]string{"IBMCLOUD_CODE_ENGINE_API_ENDPOINT"}, codeEngineEndpoint),
+		}
 
-	// Construct the service client.
-	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
-	}
+		// Construct the service client.
+		session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
+		if err == nil {
+			// Enable retries for API calls
+			c.EnableRetries(session.codeEngineClient.Service)
+			// Add custom header for analytics
+			session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
+				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			})
+		} else {
+			session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
+		}
+	})
 
 	if os.Getenv("TF_LOG") != "" {
 		logDestination := log.Writer()
@@ -3578,13 +4083,14 @@ func newSession(c *Config) (*Session, error) {
 	ibmSession := &Session{}
 
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint:   c.SoftLayerEndpointURL,
+		Timeout:    c.SoftLayerTimeout,
+		UserName:   c.SoftLayerUserName,
+		APIKey:     c.SoftLayerAPIKey,
+		Debug:      os.Getenv("TF_LOG") != "",
+		Retries:    c.RetryCount,
+		RetryWait:  c.RetryDelay,
+		HTTPClient: c.sessionHTTPClient(c.SoftLayerTimeout),
 	}
 
 	if c.IAMToken != "" {
@@ -3600,7 +4106,7 @@ func newSession(c *Config) (*Session, error) {
 	softlayerSession.AppendUserAgent(fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
 	ibmSession.SoftLayerSession = softlayerSession
 
-	if c.IAMTrustedProfileID == "" && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
+	if c.tokenAuthenticator == nil && c.IAMTrustedProfileID == "" && (c.IAMToken != "" && c.IAMRefreshToken == "") || (c.IAMToken == "" && c.IAMRefreshToken != "") {
 		return nil, fmt.Errorf("iam_token and iam_refresh_token must be provided")
 	}
 	if c.IAMTrustedProfileID != "" && c.IAMToken == "" {
@@ -3623,6 +4129,7 @@ func newSession(c *Config) (*Session, error) {
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:    c.sessionHTTPClient(c.BluemixTimeout),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3646,6 +4153,7 @@ func newSession(c *Config) (*Session, error) {
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:    c.sessionHTTPClient(c.BluemixTimeout),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3660,6 +4168,7 @@ func newSession(c *Config) (*Session, error) {
 func authenticateAPIKey(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
@@ -3718,6 +4227,7 @@ func fetchUserDetails(sess *bxsession.Session, retries int, retryDelay time.Dura
 func RefreshToken(sess *bxsession.Session) error {
 	config := sess.Config
 	tokenRefresher, err := authentication.NewIAMAuthRepository(config, &rest.Client{
+		HTTPClient: config.HTTPClient,
 		DefaultHeader: gohttp.Header{
 			"User-Agent":            []string{http.UserAgent()},
 			"X-Original-User-Agent": []string{config.UserAgent},
@@ -3739,34 +4249,24 @@ func EnvFallBack(envs []string, defaultValue string) string {
 	return defaultValue
 }
 
+// FileFallBack returns the endpoint of the service key for the visibility and
+// region from the endpoints file, or defaultValue when it has none.
 func FileFallBack(endpointsFile, visibility, key, region, defaultValue string) string {
-	var fileMap map[string]interface{}
+	var fileMap EndpointsFile
 	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, endpointsFile); f != "" {
-		jsonFile, err := os.Open(f)
-		if err != nil {
-			log.Fatalf("Unable to open Endpoints File %s", err)
-		}
-		defer jsonFile.Close()
-		bytes, err := io.ReadAll(jsonFile)
+		var err error
+		fileMap, err = loadEndpointsFileOnce(f)
 		if err != nil {
-			log.Fatalf("Unable to read Endpoints File %s", err)
-		}
-		err = json.Unmarshal([]byte(bytes), &fileMap)
-		if err != nil {
-			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
+			log.Printf("%s", err)
 		}
 	}
 
 	return fileFallBack(fileMap, visibility, key, region, defaultValue)
 }
 
-func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defaultValue string) string {
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
+func fileFallBack(fileMap EndpointsFile, visibility, key, region, defaultValue string) string {
+	if r := fileMap[key][visibility][region]; r != "" {
+		return r
 	}
 	return defaultValue
 }
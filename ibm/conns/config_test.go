@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestClientSessionLazyLoad(t *testing.T) {
+	session := &clientSession{}
+	calls := 0
+	session.lazy("vpcAPI", func() {
+		calls++
+		session.vpcErr = errors.New("unable to configure VPC")
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := session.VpcV1API(); err == nil {
+				t.Error("expected the cached configuration error")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected the initializer to run once, ran %d times", calls)
+	}
+}
+
+func TestClientSessionLoadUnregistered(t *testing.T) {
+	session := &clientSession{vpcErr: errEmptyBluemixCredentials}
+	session.load(bluemixAuthKey)
+
+	if _, err := session.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("expected %q, got %v", errEmptyBluemixCredentials, err)
+	}
+}
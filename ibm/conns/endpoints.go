@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+)
+
+// EndpointsFile is the content of the endpoints file, which maps a service
+// key, then a visibility, then a region to the endpoint of the service.
+type EndpointsFile map[string]map[string]map[string]string
+
+// EndpointsFileKeys lists the service keys allowed in the endpoints file.
+var EndpointsFileKeys = []string{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_API_GATEWAY_ENDPOINT",
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT",
+	"IBMCLOUD_CIS_API_ENDPOINT",
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"IBMCLOUD_CODE_ENGINE_API_ENDPOINT",
+	"IBMCLOUD_COMPLIANCE_API_ENDPOINT",
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"IBMCLOUD_COS_ENDPOINT",
+	"IBMCLOUD_CR_API_ENDPOINT",
+	"IBMCLOUD_CSE_ENDPOINT",
+	"IBMCLOUD_CS_API_ENDPOINT",
+	"IBMCLOUD_DL_API_ENDPOINT",
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+	"IBMCLOUD_GS_API_ENDPOINT",
+	"IBMCLOUD_GT_API_ENDPOINT",
+	"IBMCLOUD_HPCS_API_ENDPOINT",
+	"IBMCLOUD_HPCS_TKE_ENDPOINT",
+	"IBMCLOUD_IAMPAP_API_ENDPOINT",
+	"IBMCLOUD_IAM_API_ENDPOINT",
+	"IBMCLOUD_ICD_API_ENDPOINT",
+	"IBMCLOUD_IS_NG_API_ENDPOINT",
+	"IBMCLOUD_KP_API_ENDPOINT",
+	"IBMCLOUD_LOGS_API_ENDPOINT",
+	"IBMCLOUD_MCCP_API_ENDPOINT",
+	"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT",
+	"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"IBMCLOUD_PROJECT_API_ENDPOINT",
+	"IBMCLOUD_PUSH_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"IBMCLOUD_SAT_API_ENDPOINT",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT",
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"IBMCLOUD_TG_API_ENDPOINT",
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"IBMCLOUD_UAA_ENDPOINT",
+	"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT",
+}
+
+// EndpointsFileVisibilities lists the visibilities allowed in the endpoints file.
+var EndpointsFileVisibilities = []string{"public", "private", "public-and-private"}
+
+// EndpointsFileRegions lists the regions expected in the endpoints file. The
+// Cloud Object Storage keys are indexed by bucket location instead.
+var EndpointsFileRegions = []string{
+	"au-syd", "br-sao", "ca-tor", "eu-de", "eu-es", "eu-gb", "jp-osa", "jp-tok", "us-east", "us-south",
+}
+
+var endpointsFileLocationKeys = []string{"IBMCLOUD_COS_CONFIG_ENDPOINT", "IBMCLOUD_COS_ENDPOINT"}
+
+// LoadEndpointsFile reads and validates the endpoints file at path. It returns
+// an error when the file cannot be read or does not follow the structure of
+// EndpointsFile, and warnings for the keys, visibilities and regions that are
+// unknown, and thus most likely ignored.
+func LoadEndpointsFile(path string) (EndpointsFile, []string, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Unable to read the endpoints file: %s", err)
+	}
+	var content map[string]interface{}
+	if err := json.Unmarshal(bytes, &content); err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Unable to parse the endpoints file %s: %s", path, err)
+	}
+
+	var warnings []string
+	file := make(EndpointsFile, len(content))
+	for _, key := range sortedKeys(content) {
+		if !stringInSlice(key, EndpointsFileKeys) {
+			warnings = append(warnings, fmt.Sprintf("Unknown service key %q in the endpoints file %s, it is ignored", key, path))
+		}
+		visibilities, ok := content[key].(map[string]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %q must be an object of visibilities", path, key)
+		}
+
+		file[key] = make(map[string]map[string]string, len(visibilities))
+		for _, visibility := range sortedKeys(visibilities) {
+			if !stringInSlice(visibility, EndpointsFileVisibilities) {
+				warnings = append(warnings, fmt.Sprintf("Unknown visibility %q for %q in the endpoints file %s, it is ignored", visibility, key, path))
+			}
+			regions, ok := visibilities[visibility].(map[string]interface{})
+			if !ok {
+				return nil, nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: %q of %q must be an object of regions", path, visibility, key)
+			}
+
+			file[key][visibility] = make(map[string]string, len(regions))
+			for _, region := range sortedKeys(regions) {
+				if !stringInSlice(region, EndpointsFileRegions) && !stringInSlice(key, endpointsFileLocationKeys) {
+					warnings = append(warnings, fmt.Sprintf("Unknown region %q for %q in the endpoints file %s", region, key, path))
+				}
+				endpoint, ok := regions[region].(string)
+				if !ok {
+					return nil, nil, fmt.Errorf("[ERROR] Invalid endpoints file %s: the %q endpoint of %q must be a string", path, region, key)
+				}
+				file[key][visibility][region] = endpoint
+			}
+		}
+	}
+	return file, warnings, nil
+}
+
+// Endpoints files already loaded by FileFallBack, keyed by path
+var endpointsFiles sync.Map
+
+// loadEndpointsFileOnce loads the endpoints file at path, only the first
+// time it is requested.
+func loadEndpointsFileOnce(path string) (EndpointsFile, error) {
+	if file, ok := endpointsFiles.Load(path); ok {
+		return file.(EndpointsFile), nil
+	}
+	file, _, err := LoadEndpointsFile(path)
+	if err != nil {
+		return nil, err
+	}
+	endpointsFiles.Store(path, file)
+	return file, nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func stringInSlice(str string, list []string) bool {
+	for _, v := range list {
+		if v == str {
+			return true
+		}
+	}
+	return false
+}
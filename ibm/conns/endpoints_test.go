@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeEndpointsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadEndpointsFile(t *testing.T) {
+	path := writeEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"private": {"us-south": "https://vpc.example.com/v1", "us-soth": "https://typo.example.com/v1"}},
+		"IBMCLOUD_COS_ENDPOINT": {"privte": {"ams03": "https://cos.example.com"}},
+		"IBMCLOUD_UNKNOWN_ENDPOINT": {"public": {"eu-de": "https://unknown.example.com"}}
+	}`)
+
+	file, warnings, err := LoadEndpointsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("expected 3 warnings, got %q", warnings)
+	}
+	for i, expected := range []string{`visibility "privte"`, `region "us-soth"`, `service key "IBMCLOUD_UNKNOWN_ENDPOINT"`} {
+		if !strings.Contains(warnings[i], expected) {
+			t.Errorf("expected warning %d to be about %s, got %q", i, expected, warnings[i])
+		}
+	}
+
+	if endpoint := fileFallBack(file, "private", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "default"); endpoint != "https://vpc.example.com/v1" {
+		t.Errorf("unexpected endpoint %s", endpoint)
+	}
+	if endpoint := fileFallBack(file, "public", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "default"); endpoint != "default" {
+		t.Errorf("expected the default endpoint, got %s", endpoint)
+	}
+	if endpoint := FileFallBack(path, "public", "IBMCLOUD_UNKNOWN_ENDPOINT", "eu-de", "default"); endpoint != "https://unknown.example.com" {
+		t.Errorf("unexpected endpoint %s", endpoint)
+	}
+}
+
+func TestLoadEndpointsFileErrors(t *testing.T) {
+	tests := map[string]string{
+		"not json":          `{"IBMCLOUD_IS_NG_API_ENDPOINT": `,
+		"not visibilities":  `{"IBMCLOUD_IS_NG_API_ENDPOINT": "https://vpc.example.com/v1"}`,
+		"not regions":       `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"private": ["https://vpc.example.com/v1"]}}`,
+		"not a string":      `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"private": {"us-south": 443}}}`,
+		"not a JSON object": `[]`,
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := LoadEndpointsFile(writeEndpointsFile(t, content)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+
+	if _, _, err := LoadEndpointsFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+	if endpoint := FileFallBack(filepath.Join(t.TempDir(), "missing.json"), "private", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "default"); endpoint != "default" {
+		t.Errorf("expected the default endpoint, got %s", endpoint)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	httptransport "github.com/go-openapi/runtime/client"
+	"golang.org/x/time/rate"
+)
+
+// Services that support a client-side rate limit, used as keys of Config.RateLimits
+const (
+	RateLimitVPC           = "vpc"
+	RateLimitGlobalTagging = "global_tagging"
+	RateLimitCIS           = "cis"
+	RateLimitPower         = "power"
+	RateLimitIAM           = "iam"
+)
+
+// RateLimitServices lists the services that support a client-side rate limit.
+var RateLimitServices = []string{RateLimitVPC, RateLimitGlobalTagging, RateLimitCIS, RateLimitPower, RateLimitIAM}
+
+// newRateLimiters builds a token-bucket limiter for every service of limits,
+// expressed in requests per second. The bucket holds one second of requests,
+// and at least one, so that short bursts are not delayed.
+func newRateLimiters(limits map[string]float64) map[string]*rate.Limiter {
+	limiters := make(map[string]*rate.Limiter, len(limits))
+	for service, limit := range limits {
+		if limit <= 0 {
+			continue
+		}
+		limiters[service] = rate.NewLimiter(rate.Limit(limit), int(math.Max(1, math.Ceil(limit))))
+	}
+	return limiters
+}
+
+// rateLimitedTransport waits for a token of the limiter before sending each request.
+type rateLimitedTransport struct {
+	limiter   *rate.Limiter
+	transport http.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.transport.RoundTrip(req)
+}
+
+func newRateLimitedTransport(limiter *rate.Limiter, transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &rateLimitedTransport{limiter: limiter, transport: transport}
+}
+
+// rateLimit installs the rate limiter of the given service, if any, into the
+// HTTP transport of a go-sdk-core based client. When retries are enabled the
+// limiter is installed below the retry logic so that every attempt counts.
+func (session *clientSession) rateLimit(service string, baseService *core.BaseService) {
+	limiter, ok := session.rateLimiters[service]
+	if !ok || baseService == nil || baseService.Client == nil {
+		return
+	}
+
+	client := baseHTTPClient(baseService)
+	client.Transport = newRateLimitedTransport(limiter, client.Transport)
+}
+
+// rateLimitRuntime installs the rate limiter of the given service, if any,
+// into the transport of a go-openapi based client runtime.
+func (session *clientSession) rateLimitRuntime(service string, transport interface{}) {
+	limiter, ok := session.rateLimiters[service]
+	if !ok {
+		return
+	}
+
+	if rt, ok := transport.(*httptransport.Runtime); ok {
+		rt.Transport = newRateLimitedTransport(limiter, rt.Transport)
+	}
+}
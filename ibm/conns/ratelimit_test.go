@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestNewRateLimiters(t *testing.T) {
+	limiters := newRateLimiters(map[string]float64{RateLimitVPC: 0.5, RateLimitCIS: 20, RateLimitIAM: 0})
+
+	if len(limiters) != 2 {
+		t.Fatalf("expected 2 limiters, got %d", len(limiters))
+	}
+	if burst := limiters[RateLimitVPC].Burst(); burst != 1 {
+		t.Errorf("expected a burst of 1 for vpc, got %d", burst)
+	}
+	if burst := limiters[RateLimitCIS].Burst(); burst != 20 {
+		t.Errorf("expected a burst of 20 for cis, got %d", burst)
+	}
+}
+
+func TestClientSessionRateLimit(t *testing.T) {
+	session := &clientSession{rateLimiters: newRateLimiters(map[string]float64{RateLimitVPC: 10})}
+	c := &Config{RetryCount: 3, RetryDelay: RetryAPIDelay}
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "https://example.com", Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.EnableRetries(service)
+	session.rateLimit(RateLimitVPC, service)
+
+	tr := service.Client.Transport.(*retryablehttp.RoundTripper)
+	limited, ok := tr.Client.HTTPClient.Transport.(*rateLimitedTransport)
+	if !ok {
+		t.Fatal("expected the limiter to be installed below the retry logic")
+	}
+	if limited.limiter != session.rateLimiters[RateLimitVPC] {
+		t.Fatal("expected the limiter to be shared with the session")
+	}
+
+	other, err := core.NewBaseService(&core.ServiceOptions{URL: "https://example.com", Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	session.rateLimit(RateLimitCIS, other)
+	if _, ok := other.Client.Transport.(*rateLimitedTransport); ok {
+		t.Fatal("expected no limiter for a service without a rate limit")
+	}
+}
@@ -0,0 +1,402 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	httptransport "github.com/go-openapi/runtime/client"
+)
+
+// Modes of the HTTP recorder
+const (
+	RecorderModeRecord = "record"
+	RecorderModeReplay = "replay"
+)
+
+// redactedValue replaces the secrets and tokens found in the recorded interactions.
+const redactedValue = "REDACTED"
+
+// sensitiveName matches the names of the headers, query parameters and body
+// fields whose values are redacted in the recorded interactions.
+var sensitiveName = regexp.MustCompile(`(?i)^(authorization|cookie|set-cookie|x-auth-.*|.*token|api[_-]?key|.*password|passphrase|secret|.*_secret|private_key|payload|psk)$`)
+
+// jwtClaims lists the claims kept when an access token is redacted. They are
+// enough for the SDKs to read the token expiration and the account.
+var jwtClaims = []string{"exp", "iat", "iss", "sub", "account", "iam_id", "id", "realmid", "grant_type", "identifier"}
+
+// Recorder records the HTTP interactions of the provider clients to a
+// cassette file, or serves them back from it, so that tests can run without
+// network access. Secrets and tokens are redacted from the recordings.
+//
+// A cassette holds one JSON encoded interaction per line. It is opened once
+// per process, so that the provider can be configured several times, as the
+// acceptance tests do, and the interactions are appended as they happen. The
+// test binaries of go test, which run in parallel, each use their own cassette.
+type Recorder struct {
+	mode string
+	path string
+
+	mu           sync.Mutex
+	file         *os.File
+	interactions []*interaction
+	replayed     []bool
+}
+
+// recorders holds the recorders opened by the process, keyed by mode and
+// cassette file.
+var (
+	recordersMu sync.Mutex
+	recorders   = map[string]*Recorder{}
+)
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// NewRecorder returns the Recorder for the given mode and cassette file. The
+// cassette is opened on the first call of the process and the same Recorder
+// is returned afterwards. In replay mode the cassette must exist; in record
+// mode it is truncated when it is opened.
+func NewRecorder(mode, path string) (*Recorder, error) {
+	if path == "" {
+		return nil, fmt.Errorf("[ERROR] A cassette file is required by the HTTP recorder")
+	}
+	if mode != RecorderModeRecord && mode != RecorderModeReplay {
+		return nil, fmt.Errorf("[ERROR] Invalid HTTP recorder mode %q, expected %q or %q", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+	path = recorderCassettePath(path, os.Args[0])
+
+	recordersMu.Lock()
+	defer recordersMu.Unlock()
+	key := mode + ":" + path
+	if r, ok := recorders[key]; ok {
+		return r, nil
+	}
+
+	r := &Recorder{mode: mode, path: path}
+	if mode == RecorderModeRecord {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Unable to create the HTTP recorder cassette %s: %s", path, err)
+		}
+		r.file = file
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Unable to read the HTTP recorder cassette %s: %s", path, err)
+		}
+		defer file.Close()
+		decoder := json.NewDecoder(file)
+		for {
+			recorded := &interaction{}
+			err := decoder.Decode(recorded)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Unable to parse the HTTP recorder cassette %s: %s", path, err)
+			}
+			r.interactions = append(r.interactions, recorded)
+		}
+		r.replayed = make([]bool, len(r.interactions))
+	}
+	recorders[key] = r
+	return r, nil
+}
+
+// recorderCassettePath returns the cassette file used by the given program.
+// go test runs the packages in parallel processes which would truncate and
+// overwrite each other's cassette, so a test binary gets its own cassette,
+// named after its package: cassette.json becomes cassette.vpc.json for the
+// vpc.test binary.
+func recorderCassettePath(path, program string) string {
+	binary := strings.TrimSuffix(filepath.Base(program), ".exe")
+	if !strings.HasSuffix(binary, ".test") {
+		return path
+	}
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + strings.TrimSuffix(binary, ".test") + ext
+}
+
+// Close closes the cassette of r. A later NewRecorder call for the same mode
+// and cassette opens it again.
+func (r *Recorder) Close() error {
+	recordersMu.Lock()
+	delete(recorders, r.mode+":"+r.path)
+	recordersMu.Unlock()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// Transport wraps transport so that its requests are recorded or replayed.
+func (r *Recorder) Transport(transport http.RoundTripper) http.RoundTripper {
+	if r == nil {
+		return transport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &recorderTransport{recorder: r, transport: transport}
+}
+
+// HTTPClient returns a client that records or replays its requests, or nil
+// when r is nil so that the SDKs keep building their default client.
+func (r *Recorder) HTTPClient(timeout time.Duration) *http.Client {
+	if r == nil {
+		return nil
+	}
+	return &http.Client{Timeout: timeout, Transport: r.Transport(nil)}
+}
+
+// recordService installs the recorder, if any, into the HTTP transport of a
+// go-sdk-core based client.
+func (c *Config) recordService(service *core.BaseService) {
+	if c.recorder == nil || service == nil || service.Client == nil {
+		return
+	}
+	client := baseHTTPClient(service)
+	if _, ok := client.Transport.(*recorderTransport); !ok {
+		client.Transport = c.recorder.Transport(client.Transport)
+	}
+}
+
+// recordRuntime installs the recorder, if any, into the transport of a
+// go-openapi based client runtime.
+func (c *Config) recordRuntime(transport interface{}) {
+	if rt, ok := transport.(*httptransport.Runtime); ok && c.recorder != nil {
+		rt.Transport = c.recorder.Transport(rt.Transport)
+	}
+}
+
+type recorderTransport struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+func (t *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.recorder.mode == RecorderModeReplay {
+		return t.recorder.replay(req)
+	}
+
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := &interaction{
+		Request: recordedRequest{
+			Method:  req.Method,
+			URL:     redactURL(req.URL),
+			Headers: redactHeaders(req.Header),
+			Body:    redactBody(reqBody),
+		},
+		Response: recordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    redactHeaders(resp.Header),
+			Body:       redactBody(respBody),
+		},
+	}
+	return resp, t.recorder.record(recorded)
+}
+
+func (r *Recorder) record(recorded *interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return fmt.Errorf("[ERROR] The HTTP recorder cassette %s is closed", r.path)
+	}
+	if err := json.NewEncoder(r.file).Encode(recorded); err != nil {
+		return fmt.Errorf("[ERROR] Unable to write the HTTP recorder cassette %s: %s", r.path, err)
+	}
+	return nil
+}
+
+// replay serves the first interaction not replayed yet that has the method
+// and URL of req. Interactions are replayed in the order they were recorded.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	target := redactURL(req.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, recorded := range r.interactions {
+		if r.replayed[i] || recorded.Request.Method != req.Method || recorded.Request.URL != target {
+			continue
+		}
+		r.replayed[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.Response.StatusCode, http.StatusText(recorded.Response.StatusCode)),
+			StatusCode:    recorded.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Response.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(recorded.Response.Body)),
+			ContentLength: int64(len(recorded.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("[ERROR] No interaction recorded in %s for %s %s", r.path, req.Method, target)
+}
+
+// readBody reads a request or response body and replaces it with a copy.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for name := range redacted {
+		if sensitiveName.MatchString(name) {
+			redacted[name] = []string{redactedValue}
+		}
+	}
+	return redacted
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	for name := range query {
+		if sensitiveName.MatchString(name) {
+			query.Set(name, redactedValue)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// redactBody redacts the sensitive fields of a JSON or form encoded body.
+// Other bodies are recorded as is.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err == nil && !decoder.More() {
+		if redacted, err := json.Marshal(redactJSON(document)); err == nil {
+			return string(redacted)
+		}
+	}
+
+	if form, err := url.ParseQuery(string(body)); err == nil && strings.Contains(string(body), "=") {
+		for name := range form {
+			if sensitiveName.MatchString(name) {
+				form.Set(name, redactedValue)
+			}
+		}
+		return form.Encode()
+	}
+
+	return string(body)
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			if !sensitiveName.MatchString(name) {
+				v[name] = redactJSON(field)
+				continue
+			}
+			if token, ok := field.(string); ok && strings.Count(token, ".") == 2 {
+				v[name] = redactJWT(token)
+				continue
+			}
+			if _, ok := field.(string); ok {
+				v[name] = redactedValue
+				continue
+			}
+			v[name] = redactJSON(field)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+	}
+	return value
+}
+
+// redactJWT returns an unsigned copy of a JWT that only keeps the jwtClaims,
+// so that the SDKs can still read it when it is replayed.
+func redactJWT(token string) string {
+	parts := strings.Split(token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return redactedValue
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return redactedValue
+	}
+
+	kept := make(map[string]interface{})
+	for _, name := range jwtClaims {
+		if claim, ok := claims[name]; ok {
+			kept[name] = claim
+		}
+	}
+	data, err := json.Marshal(kept)
+	if err != nil {
+		return redactedValue
+	}
+	return strings.Join([]string{parts[0], base64.RawURLEncoding.EncodeToString(data), redactedValue}, ".")
+}
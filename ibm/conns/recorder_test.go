@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+)
+
+func TestRecorder(t *testing.T) {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"exp":1700000000,"account":{"bss":"abc"},"email":"user@example.com"}`))
+	token := "eyJhbGciOiJSUzI1NiJ9." + claims + ".signature"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"access_token":"`+token+`","refresh_token":"opaque","expires_in":3600,"name":"vpc-1"}`)
+	}))
+	defer server.Close()
+
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := NewRecorder(RecorderModeRecord, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/identity/token?apikey=secret-key", strings.NewReader("grant_type=urn&apikey=secret-key"))
+	req.Header.Set("Authorization", "Bearer secret-token")
+	resp, err := recorder.HTTPClient(0).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(recorded), "opaque") {
+		t.Fatalf("expected the live response to be returned, got %s", recorded)
+	}
+
+	// configuring the provider again reuses the open cassette
+	again, err := NewRecorder(RecorderModeRecord, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != recorder {
+		t.Fatal("expected the cassette to be opened once per process")
+	}
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/v1/vpcs", nil)
+	resp, err = again.HTTPClient(0).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if recorder.path != filepath.Join(filepath.Dir(cassette), "cassette.conns.json") {
+		t.Fatalf("expected a cassette named after the test binary, got %s", recorder.path)
+	}
+	data, err := os.ReadFile(recorder.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 2 {
+		t.Fatalf("expected 2 interactions appended to the cassette, got %d", lines)
+	}
+	for _, secret := range []string{"secret-key", "secret-token", "opaque", "signature", "user@example.com"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("expected %q to be redacted from the cassette", secret)
+		}
+	}
+
+	replayer, err := NewRecorder(RecorderModeReplay, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer replayer.Close()
+	server.Close()
+
+	req, _ = http.NewRequest(http.MethodPost, server.URL+"/identity/token?apikey=another-key", strings.NewReader("grant_type=urn&apikey=another-key"))
+	resp, err = replayer.HTTPClient(0).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(replayed), `"name":"vpc-1"`) {
+		t.Fatalf("unexpected replayed response %d: %s", resp.StatusCode, replayed)
+	}
+
+	redactedClaims := base64.RawURLEncoding.EncodeToString([]byte(`{"account":{"bss":"abc"},"exp":1700000000}`))
+	if !strings.Contains(string(replayed), "eyJhbGciOiJSUzI1NiJ9."+redactedClaims+".REDACTED") {
+		t.Fatalf("expected an unsigned access token keeping the expiration, got %s", replayed)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/v1/vpcs", nil)
+	resp, err = replayer.HTTPClient(0).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if _, err := replayer.HTTPClient(0).Do(req); err == nil {
+		t.Fatal("expected an error once every interaction was replayed")
+	}
+}
+
+func TestRecorderCassettePath(t *testing.T) {
+	testcases := []struct {
+		path, program, expected string
+	}{
+		{"/tmp/cassette.json", "/tmp/go-build1/b001/vpc.test", "/tmp/cassette.vpc.json"},
+		{"cassette.json", "cos.test.exe", "cassette.cos.json"},
+		{"/tmp/cassette", "/tmp/go-build1/b001/vpc.test", "/tmp/cassette.vpc"},
+		{"/tmp/cassette.json", "/usr/bin/terraform-provider-ibm", "/tmp/cassette.json"},
+	}
+	for _, tc := range testcases {
+		if path := recorderCassettePath(tc.path, tc.program); path != tc.expected {
+			t.Errorf("expected %s for %s run by %s, got %s", tc.expected, tc.path, tc.program, path)
+		}
+	}
+}
+
+type failingTransport struct {
+	t *testing.T
+}
+
+func (f failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.t.Errorf("unexpected network access to %s", req.URL)
+	return nil, fmt.Errorf("network access is not allowed in replay mode")
+}
+
+func TestAuthenticateAPIKeyReplay(t *testing.T) {
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = failingTransport{t: t}
+	defer func() { http.DefaultTransport = defaultTransport }()
+
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	tokenResponse := `{"access_token":"access-%d","refresh_token":"refresh","token_type":"Bearer","expires_in":3600}`
+	interactions := ""
+	for i := 1; i <= 2; i++ {
+		interactions += fmt.Sprintf(`{"request":{"method":"POST","url":"https://iam.example.com/identity/token"},"response":{"status_code":200,"headers":{"Content-Type":["application/json"]},"body":%q}}`+"\n", fmt.Sprintf(tokenResponse, i))
+	}
+	if err := os.WriteFile(recorderCassettePath(cassette, os.Args[0]), []byte(interactions), 0600); err != nil {
+		t.Fatal(err)
+	}
+	replayer, err := NewRecorder(RecorderModeReplay, cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer replayer.Close()
+
+	endpoint := "https://iam.example.com"
+	sess, err := bxsession.New(&bluemix.Config{
+		BluemixAPIKey:         "replay-api-key",
+		TokenProviderEndpoint: &endpoint,
+		HTTPClient:            replayer.HTTPClient(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := authenticateAPIKey(sess); err != nil {
+		t.Fatal(err)
+	}
+	if sess.Config.IAMAccessToken != "Bearer access-1" {
+		t.Fatalf("expected the replayed access token, got %s", sess.Config.IAMAccessToken)
+	}
+	if err := RefreshToken(sess); err != nil {
+		t.Fatal(err)
+	}
+	if sess.Config.IAMAccessToken != "Bearer access-2" {
+		t.Fatalf("expected the replayed refreshed token, got %s", sess.Config.IAMAccessToken)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// EnableRetries enables automatic retries on a go-sdk-core based service
+// using the retry policy configured on the provider. The HTTP recorder, when
+// configured, is installed below the retry logic so that every attempt is
+// recorded.
+//
+// Requests are attempted at most RetryMaxAttempts times, falling back to
+// RetryCount retries when unset. The wait between attempts grows exponentially
+// up to RetryMaxBackoff (RetryDelay when unset), unless the service answers
+// with a Retry-After header, which is always honored. Connection errors are
+// retried, as are the status codes in RetryOnStatusCodes, or 429 and 5xx
+// (except 501) when none are configured.
+func (c *Config) EnableRetries(service *core.BaseService) {
+	defer c.recordService(service)
+
+	maxAttempts := c.RetryMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = c.RetryCount + 1
+	}
+	if maxAttempts <= 1 {
+		service.DisableRetries()
+		return
+	}
+
+	maxBackoff := c.RetryMaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = c.RetryDelay
+	}
+	service.EnableRetries(maxAttempts-1, maxBackoff)
+
+	if tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		tr.Client.CheckRetry = retryPolicy(c.RetryOnStatusCodes)
+		tr.Client.Backoff = core.IBMCloudSDKBackoffPolicy
+	}
+}
+
+// baseHTTPClient returns the client that sends the individual requests of
+// service, which sits below the retry logic when retries are enabled.
+func baseHTTPClient(service *core.BaseService) *http.Client {
+	if tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		return tr.Client.HTTPClient
+	}
+	return service.Client
+}
+
+// retryPolicy returns the retryablehttp.CheckRetry used for the given status
+// codes. Without status codes the default go-sdk-core policy is used.
+func retryPolicy(statusCodes []int) retryablehttp.CheckRetry {
+	if len(statusCodes) == 0 {
+		return core.IBMCloudSDKRetryPolicy
+	}
+
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if err != nil || ctx.Err() != nil || resp == nil {
+			return core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+		}
+		for _, code := range statusCodes {
+			if resp.StatusCode == code {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	cases := []struct {
+		statusCodes []int
+		statusCode  int
+		retry       bool
+	}{
+		{nil, 429, true},
+		{nil, 503, true},
+		{nil, 501, false},
+		{nil, 404, false},
+		{[]int{409, 429}, 409, true},
+		{[]int{409, 429}, 429, true},
+		{[]int{409, 429}, 503, false},
+	}
+
+	for _, tc := range cases {
+		retry, _ := retryPolicy(tc.statusCodes)(context.Background(), &http.Response{StatusCode: tc.statusCode}, nil)
+		if retry != tc.retry {
+			t.Errorf("retryPolicy(%v) for status %d: got %t, expected %t", tc.statusCodes, tc.statusCode, retry, tc.retry)
+		}
+	}
+}
+
+func TestConfigEnableRetries(t *testing.T) {
+	c := &Config{RetryCount: 10, RetryDelay: RetryAPIDelay, RetryMaxAttempts: 3, RetryMaxBackoff: time.Minute}
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "https://example.com", Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.EnableRetries(service)
+	tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper)
+	if !ok {
+		t.Fatal("expected retries to be enabled")
+	}
+	if tr.Client.RetryMax != 2 || tr.Client.RetryWaitMax != time.Minute {
+		t.Fatalf("unexpected retry configuration: max %d, wait %s", tr.Client.RetryMax, tr.Client.RetryWaitMax)
+	}
+
+	c.RetryMaxAttempts = 1
+	c.EnableRetries(service)
+	if _, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		t.Fatal("expected retries to be disabled")
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	slsession "github.com/softlayer/softlayer-go/session"
+)
+
+// tokenRefreshInterval is how often the token manager checks the IAM access
+// token. The authenticators refresh a token once 80% of its lifetime is
+// elapsed, so an interval far below the usual lifetime of one hour keeps the
+// token valid at all times.
+var tokenRefreshInterval = time.Minute
+
+// tokenManager refreshes the IAM access token of the provider ahead of its
+// expiry, so that long running operations, such as the wait for a cluster or
+// a database, never fail with an expired token.
+//
+// The go-sdk-core based clients, including the Power Virtual Server session,
+// share the authenticator of the manager, which refreshes the token on its
+// own. The bluemix-go and SoftLayer sessions hold the token as a string, which
+// the manager never writes, since the sessions are read concurrently by the
+// resources. Instead, the current token replaces the one of the requests of
+// their HTTP clients, and of the copies of the sessions handed out by the
+// client session.
+type tokenManager struct {
+	authenticator core.Authenticator
+
+	startOnce sync.Once
+}
+
+// newTokenManager returns the token manager of the credentials of c, or nil
+// when the credentials cannot be refreshed, for example with an IAM access
+// token only.
+func (c *Config) newTokenManager(iamURL string) *tokenManager {
+	authenticator := c.tokenAuthenticator
+	if authenticator == nil && c.BluemixAPIKey != "" {
+		authenticator = &core.IamAuthenticator{
+			ApiKey: c.BluemixAPIKey,
+			URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+		}
+	} else if authenticator == nil && c.IAMRefreshToken != "" {
+		// Construct the IamAuthenticator with the IAM refresh token.
+		authenticator = &core.IamAuthenticator{
+			RefreshToken: c.IAMRefreshToken,
+			ClientId:     "bx",
+			ClientSecret: "bx",
+			URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+		}
+	}
+	if authenticator == nil {
+		return nil
+	}
+	if iamAuthenticator, ok := authenticator.(*core.IamAuthenticator); ok && c.recorder != nil {
+		iamAuthenticator.Client = c.recorder.HTTPClient(c.BluemixTimeout)
+	}
+	return &tokenManager{authenticator: authenticator}
+}
+
+// Token returns the current IAM access token, as a bearer token.
+func (m *tokenManager) Token() (string, error) {
+	return bearerToken(m.authenticator)
+}
+
+// bluemixSession returns a copy of sess carrying the current token.
+func (m *tokenManager) bluemixSession(sess *bxsession.Session) *bxsession.Session {
+	token, err := m.Token()
+	if sess == nil || err != nil {
+		return sess
+	}
+	config := *sess.Config
+	config.IAMAccessToken = token
+	return &bxsession.Session{Config: &config}
+}
+
+// softLayerSession returns a copy of sess carrying the current token, unless
+// it authenticates with a classic infrastructure API key.
+func (m *tokenManager) softLayerSession(sess *slsession.Session) *slsession.Session {
+	if sess == nil || sess.APIKey != "" {
+		return sess
+	}
+	token, err := m.Token()
+	if err != nil {
+		return sess
+	}
+	copied := *sess
+	copied.IAMToken = token
+	return &copied
+}
+
+// start refreshes the token every tokenRefreshInterval, in the background,
+// until ctx is done.
+func (m *tokenManager) start(ctx context.Context) {
+	m.startOnce.Do(func() {
+		ticker := time.NewTicker(tokenRefreshInterval)
+		go func() {
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if _, err := m.Token(); err != nil {
+						log.Printf("[WARN] Unable to refresh the IAM access token: %s", err)
+					}
+				}
+			}
+		}()
+	})
+}
+
+// sessionHTTPClient returns the HTTP client of the bluemix-go and SoftLayer
+// sessions, which replaces the bearer token of their requests by the current
+// one of the token manager, if any.
+func (c *Config) sessionHTTPClient(timeout time.Duration) *http.Client {
+	client := c.recorder.HTTPClient(timeout)
+	if c.tokenManager == nil {
+		return client
+	}
+	if client == nil {
+		client = &http.Client{Timeout: timeout}
+	}
+	client.Transport = &tokenTransport{
+		base:    client.Transport,
+		manager: c.tokenManager,
+	}
+	return client
+}
+
+// tokenTransport sets the current token of manager in the requests that carry
+// a bearer token. Other requests, such as the ones to the IAM token endpoint,
+// are left as is.
+type tokenTransport struct {
+	base    http.RoundTripper
+	manager *tokenManager
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		return base.RoundTrip(req)
+	}
+
+	token, err := t.manager.Token()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occured while refreshing the IAM access token: %s", err)
+	}
+	// A RoundTripper must not modify the request it is given
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", token)
+	return base.RoundTrip(req)
+}
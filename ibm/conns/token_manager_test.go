@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenManager(t *testing.T) {
+	var issued int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("apikey") != "api-key" {
+			t.Errorf("unexpected token request %v", r.Form)
+		}
+		// The tokens expire right away, so that each use refreshes them
+		n := atomic.AddInt32(&issued, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","refresh_token":"","token_type":"Bearer","expires_in":3600,"expiration":%d}`, n, time.Now().Unix())
+	}))
+	defer server.Close()
+	os.Setenv("IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+	defer os.Unsetenv("IBMCLOUD_IAM_API_ENDPOINT")
+
+	c := &Config{BluemixAPIKey: "api-key", Region: "us-south"}
+	manager := c.newTokenManager("https://iam.cloud.ibm.com")
+	if manager == nil {
+		t.Fatal("expected a token manager for an API key")
+	}
+	token, err := manager.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "Bearer token-1" {
+		t.Errorf("unexpected token %q", token)
+	}
+
+	// The sessions handed out carry the current token, while the shared
+	// sessions are left as is.
+	sess, err := newSession(&Config{IAMToken: "Bearer stale", IAMRefreshToken: "refresh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &clientSession{session: sess, tokenManager: manager}
+	bmxSess, err := client.BluemixSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bmxSess.Config.IAMAccessToken != "Bearer token-2" || client.SoftLayerSession().IAMToken != "Bearer token-3" {
+		t.Errorf("the sessions do not carry the current token, got %q and %q", bmxSess.Config.IAMAccessToken, client.SoftLayerSession().IAMToken)
+	}
+	if sess.BluemixSession.Config.IAMAccessToken != "Bearer stale" || sess.SoftLayerSession.IAMToken != "Bearer stale" {
+		t.Errorf("the shared sessions were modified")
+	}
+
+	// The SoftLayer session of a classic infrastructure API key is left as is
+	sess.SoftLayerSession.IAMToken = ""
+	sess.SoftLayerSession.APIKey = "classic-key"
+	if client.SoftLayerSession().IAMToken != "" {
+		t.Errorf("the SoftLayer session of an API key should not get a token")
+	}
+}
+
+func TestTokenManagerStart(t *testing.T) {
+	defer func(interval time.Duration) { tokenRefreshInterval = interval }(tokenRefreshInterval)
+	tokenRefreshInterval = time.Millisecond
+
+	var issued int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&issued, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","refresh_token":"","token_type":"Bearer","expires_in":3600,"expiration":%d}`, n, time.Now().Unix())
+	}))
+	defer server.Close()
+
+	c := &Config{BluemixAPIKey: "api-key"}
+	manager := c.newTokenManager(server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	manager.start(ctx)
+	for atomic.LoadInt32(&issued) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The refresh ends with the context
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+	stopped := atomic.LoadInt32(&issued)
+	time.Sleep(20 * time.Millisecond)
+	if n := atomic.LoadInt32(&issued); n != stopped {
+		t.Errorf("the token was refreshed %d times after the context was done", n-stopped)
+	}
+}
+
+func TestTokenManagerTransport(t *testing.T) {
+	var authorization []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/identity/token" {
+			fmt.Fprintf(w, `{"access_token":"fresh-token","refresh_token":"","token_type":"Bearer","expires_in":3600,"expiration":%d}`, time.Now().Add(time.Hour).Unix())
+			return
+		}
+		authorization = append(authorization, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	c := &Config{BluemixAPIKey: "api-key"}
+	c.tokenManager = c.newTokenManager(server.URL)
+	client := c.sessionHTTPClient(time.Minute)
+	for _, header := range []string{"Bearer stale-token", "Basic Yng6Yng="} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/resource", nil)
+		req.Header.Set("Authorization", header)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if req.Header.Get("Authorization") != header {
+			t.Errorf("the request was modified")
+		}
+	}
+	if len(authorization) != 2 || authorization[0] != "Bearer fresh-token" || authorization[1] != "Basic Yng6Yng=" {
+		t.Errorf("unexpected Authorization headers %q", authorization)
+	}
+}
+
+func TestTokenManagerStaticToken(t *testing.T) {
+	c := &Config{IAMToken: "Bearer token"}
+	if c.newTokenManager("https://iam.cloud.ibm.com") != nil {
+		t.Errorf("an IAM access token alone cannot be refreshed")
+	}
+	if client := c.sessionHTTPClient(time.Minute); client != nil {
+		t.Errorf("expected the default HTTP client")
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AssumeTrustedProfile is the trusted profile assumed with the API key, so
+// that every client acts as the profile, possibly in another account.
+type AssumeTrustedProfile struct {
+	// Exactly one of ProfileID, ProfileCRN and ProfileName
+	ProfileID   string
+	ProfileCRN  string
+	ProfileName string
+	// Account of the profile, required with ProfileName only
+	AccountID string
+}
+
+// newAssumeTrustedProfileAuthenticator builds the authenticator exchanging
+// the IAM access token of the API key for one of the trusted profile. The
+// authenticator refreshes both tokens on its own.
+func (c *Config) newAssumeTrustedProfileAuthenticator(iamURL string) (core.Authenticator, error) {
+	if c.BluemixAPIKey == "" {
+		return nil, fmt.Errorf("[ERROR] ibmcloud_api_key must be provided to assume a trusted profile")
+	}
+	if c.ComputeResourceAuth != "" || c.IAMToken != "" || c.IAMRefreshToken != "" {
+		return nil, fmt.Errorf("[ERROR] Assuming a trusted profile cannot be combined with compute resource authentication or an IAM token")
+	}
+
+	profile := c.AssumeTrustedProfile
+	builder := core.NewIamAssumeAuthenticatorBuilder().
+		SetApiKey(c.BluemixAPIKey).
+		SetIAMProfileID(profile.ProfileID).
+		SetIAMProfileCRN(profile.ProfileCRN).
+		SetIAMProfileName(profile.ProfileName).
+		SetIAMAccountID(profile.AccountID).
+		SetURL(EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL))
+	if c.recorder != nil {
+		builder.SetClient(c.recorder.HTTPClient(c.BluemixTimeout))
+	}
+	authenticator, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid assume_trusted_profile: %s", err)
+	}
+	return authenticator, nil
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAssumeTrustedProfile(t *testing.T) {
+	var assumed int32
+	var authorization atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/identity/token" {
+			authorization.Store(r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{}`)
+			return
+		}
+
+		r.ParseForm()
+		switch r.Form.Get("grant_type") {
+		case "urn:ibm:params:oauth:grant-type:apikey":
+			fmt.Fprintf(w, `{"access_token":"user-token","refresh_token":"","token_type":"Bearer","expires_in":3600,"expiration":%d}`, time.Now().Add(time.Hour).Unix())
+		case "urn:ibm:params:oauth:grant-type:assume":
+			if r.Form.Get("access_token") != "user-token" || r.Form.Get("profile_id") != "Profile-1" {
+				t.Errorf("unexpected assume request %v", r.Form)
+			}
+			// The tokens expire right away, so that each use refreshes them
+			n := atomic.AddInt32(&assumed, 1)
+			fmt.Fprintf(w, `{"access_token":"profile-token-%d","refresh_token":"","token_type":"Bearer","expires_in":3600,"expiration":%d}`, n, time.Now().Unix())
+		default:
+			t.Errorf("unexpected token request %v", r.Form)
+		}
+	}))
+	defer server.Close()
+	os.Setenv("IBMCLOUD_IAM_API_ENDPOINT", server.URL)
+	defer os.Unsetenv("IBMCLOUD_IAM_API_ENDPOINT")
+
+	c := &Config{
+		BluemixAPIKey:        "api-key",
+		AssumeTrustedProfile: &AssumeTrustedProfile{ProfileID: "Profile-1"},
+		Region:               "us-south",
+		RetryDelay:           time.Millisecond,
+	}
+	session, err := c.ClientSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sess := session.(*clientSession).session
+	if token := sess.BluemixSession.Config.IAMAccessToken; token != "Bearer profile-token-1" {
+		t.Errorf("unexpected IAM access token %q", token)
+	}
+	if sess.BluemixSession.Config.BluemixAPIKey != "" {
+		t.Errorf("the IBM Cloud session should not use the API key")
+	}
+	if c.BluemixAPIKey != "api-key" {
+		t.Errorf("the configuration should keep its API key")
+	}
+	if sess.SoftLayerSession.IAMToken != "Bearer profile-token-1" {
+		t.Errorf("unexpected SoftLayer IAM token %q", sess.SoftLayerSession.IAMToken)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v2/resource_instances", nil)
+	req.Header.Set("Authorization", sess.BluemixSession.Config.IAMAccessToken)
+	resp, err := sess.BluemixSession.Config.HTTPClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got := authorization.Load(); got != "Bearer profile-token-2" {
+		t.Errorf("expected the refreshed token, got %v", got)
+	}
+}
+
+func TestAssumeTrustedProfileErrors(t *testing.T) {
+	tests := map[string]*Config{
+		"no api key": {
+			AssumeTrustedProfile: &AssumeTrustedProfile{ProfileID: "Profile-1"},
+		},
+		"iam token": {
+			BluemixAPIKey:        "api-key",
+			IAMToken:             "Bearer token",
+			AssumeTrustedProfile: &AssumeTrustedProfile{ProfileID: "Profile-1"},
+		},
+		"name without account": {
+			BluemixAPIKey:        "api-key",
+			AssumeTrustedProfile: &AssumeTrustedProfile{ProfileName: "deployer"},
+		},
+		"id and crn": {
+			BluemixAPIKey:        "api-key",
+			AssumeTrustedProfile: &AssumeTrustedProfile{ProfileID: "Profile-1", ProfileCRN: "crn:v1:bluemix:public:iam-identity::a/123::profile:Profile-1"},
+		},
+	}
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := c.newAssumeTrustedProfileAuthenticator("https://iam.cloud.ibm.com"); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
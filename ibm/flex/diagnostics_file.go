@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Response headers holding the IBM Cloud trace ID, in order of preference
+var traceIDHeaders = []string{"X-Global-Transaction-Id", "Transaction-Id", "X-Correlation-Id"}
+
+// ProblemRecord is the machine-readable form of a TerraformProblem written
+// to the diagnostics file.
+type ProblemRecord struct {
+	Time       string         `json:"time"`
+	ID         string         `json:"id"`
+	Summary    string         `json:"summary"`
+	Severity   string         `json:"severity"`
+	Resource   string         `json:"resource,omitempty"`
+	Operation  string         `json:"operation,omitempty"`
+	StatusCode int            `json:"status_code,omitempty"`
+	RequestID  string         `json:"request_id,omitempty"`
+	TraceID    string         `json:"ibm_trace_id,omitempty"`
+	Hint       string         `json:"hint,omitempty"`
+	CausedBy   []ProblemCause `json:"caused_by,omitempty"`
+}
+
+// ProblemCause describes one problem, or native error, of the chain that
+// caused a TerraformProblem.
+type ProblemCause struct {
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type"`
+	Summary     string `json:"summary"`
+	Component   string `json:"component,omitempty"`
+	Function    string `json:"function,omitempty"`
+	OperationID string `json:"operation_id,omitempty"`
+	StatusCode  int    `json:"status_code,omitempty"`
+}
+
+var diagnosticsFile struct {
+	sync.Mutex
+	path string
+}
+
+// SetDiagnosticsFile configures the file that problem records are appended
+// to, one JSON document per line. An empty path disables the records.
+func SetDiagnosticsFile(path string) {
+	diagnosticsFile.Lock()
+	defer diagnosticsFile.Unlock()
+	diagnosticsFile.path = path
+}
+
+// WriteDiagnostic appends the record of problem to the diagnostics file, if
+// one is configured. Failures are logged and otherwise ignored, so that they
+// never hide the original problem.
+func WriteDiagnostic(problem *TerraformProblem) {
+	diagnosticsFile.Lock()
+	defer diagnosticsFile.Unlock()
+	if diagnosticsFile.path == "" || problem == nil {
+		return
+	}
+
+	data, err := json.Marshal(problem.GetRecord())
+	if err != nil {
+		log.Printf("[WARN] Unable to encode the problem record: %s", err)
+		return
+	}
+	file, err := os.OpenFile(diagnosticsFile.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Printf("[WARN] Unable to open the diagnostics file %s: %s", diagnosticsFile.path, err)
+		return
+	}
+	defer file.Close()
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		log.Printf("[WARN] Unable to write to the diagnostics file %s: %s", diagnosticsFile.path, err)
+	}
+}
+
+// GetRecord returns the machine-readable form of the problem. The HTTP status,
+// request ID and trace ID come from the first HTTP problem of the chain.
+func (e *TerraformProblem) GetRecord() *ProblemRecord {
+	record := &ProblemRecord{
+		Time:      time.Now().UTC().Format(time.RFC3339),
+		ID:        e.GetID(),
+		Summary:   e.Summary,
+		Severity:  string(e.Severity),
+		Resource:  e.Resource,
+		Operation: e.Operation,
+		CausedBy:  problemCauses(e.IBMProblem),
+	}
+	if hint := e.GetHint(); hint != nil {
+		record.Hint = hint.Name
+	}
+
+	var httpProblem *core.HTTPProblem
+	if errors.As(e, &httpProblem) && httpProblem.Response != nil {
+		record.StatusCode = httpProblem.Response.GetStatusCode()
+		if headers := httpProblem.Response.GetHeaders(); headers != nil {
+			record.RequestID = headers.Get("X-Request-Id")
+			for _, header := range traceIDHeaders {
+				if traceID := headers.Get(header); traceID != "" {
+					record.TraceID = traceID
+					break
+				}
+			}
+		}
+	}
+
+	return record
+}
+
+// problemCauses walks the "caused by" chain of problem, ending with the
+// native error that started it, if any.
+func problemCauses(problem *core.IBMProblem) []ProblemCause {
+	var causes []ProblemCause
+	for problem != nil {
+		causedBy := problem.GetCausedBy()
+		if causedBy == nil {
+			for _, err := range problem.Unwrap() {
+				causes = append(causes, ProblemCause{Type: "error", Summary: err.Error()})
+			}
+			break
+		}
+
+		cause := ProblemCause{ID: causedBy.GetID(), Summary: causedBy.Error()}
+		problem = nil
+		switch p := causedBy.(type) {
+		case *TerraformProblem:
+			cause.Type = "terraform"
+			cause.Component = componentName(p.Component)
+			problem = p.IBMProblem
+		case *core.SDKProblem:
+			cause.Type = "sdk"
+			cause.Component = componentName(p.Component)
+			cause.Function = p.Function
+			problem = p.IBMProblem
+		case *core.HTTPProblem:
+			cause.Type = "http"
+			cause.Component = componentName(p.Component)
+			cause.OperationID = p.OperationID
+			if p.Response != nil {
+				cause.StatusCode = p.Response.GetStatusCode()
+			}
+			problem = p.IBMProblem
+		default:
+			cause.Type = "problem"
+		}
+		causes = append(causes, cause)
+	}
+	return causes
+}
+
+func componentName(component *core.ProblemComponent) string {
+	if component == nil {
+		return ""
+	}
+	return component.Name
+}
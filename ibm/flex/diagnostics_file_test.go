@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestWriteDiagnostic(t *testing.T) {
+	err := apiError(t, http.StatusNotFound, `{"errors":[{"code":"not_found","message":"VPC not found"}]}`, "github.com/IBM/vpc-go-sdk")
+
+	path := filepath.Join(t.TempDir(), "diagnostics.json")
+	SetDiagnosticsFile(path)
+	defer SetDiagnosticsFile("")
+
+	TerraformErrorf(err, "GetVPCWithContext failed", "ibm_is_vpc", "read").GetDiag()
+	TerraformErrorf(err, "GetVPCWithContext failed", "ibm_is_vpc", "read").GetDiag()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var records []ProblemRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record ProblemRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid record %s: %s", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	record := records[0]
+	if record.ID == "" || record.ID != records[1].ID {
+		t.Errorf("expected a stable problem ID, got %q and %q", record.ID, records[1].ID)
+	}
+	if record.Resource != "ibm_is_vpc" || record.Operation != "read" || record.Severity != "error" {
+		t.Errorf("unexpected resource, operation or severity: %+v", record)
+	}
+	if record.StatusCode != http.StatusNotFound || record.RequestID != "request-1" || record.TraceID != "trace-1" {
+		t.Errorf("unexpected HTTP details: %+v", record)
+	}
+	if len(record.CausedBy) != 2 || record.CausedBy[0].Type != "sdk" || record.CausedBy[1].Type != "http" || record.CausedBy[1].StatusCode != http.StatusNotFound {
+		t.Errorf("expected the SDK and HTTP problems in the caused_by chain: %+v", record.CausedBy)
+	}
+}
+
+// apiError returns the error of a service SDK for an API call answering with
+// the given status code and JSON body.
+func apiError(t *testing.T, statusCode int, body, component string) error {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "request-1")
+		w.Header().Set("X-Global-Transaction-Id", "trace-1")
+		w.WriteHeader(statusCode)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := core.NewRequestBuilder(core.GET)
+	if _, err := builder.ResolveRequestURL(server.URL, "/resources/{id}", map[string]string{"id": "r006-1"}); err != nil {
+		t.Fatal(err)
+	}
+	request, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result map[string]interface{}
+	_, err = service.Request(request, &result)
+	if err == nil {
+		t.Fatal("expected the request to fail")
+	}
+
+	// Service SDKs enrich the problems of the core and wrap them into their own component
+	sdkComponent := core.NewProblemComponent(component, "0.1.0")
+	core.EnrichHTTPProblem(err, "get_resource", sdkComponent)
+	return core.SDKErrorf(err, "", "http-request-err", sdkComponent)
+}
@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ProblemSignature describes a family of problems that share a root cause,
+// regardless of the resource and operation they occur in. Every criterion
+// that is set must match: the HTTP status code must be one of StatusCodes, and
+// the component of the service SDK one of Components. When both Codes and
+// Message are set, matching either one of them is enough.
+type ProblemSignature struct {
+	StatusCodes []int
+	Components  []string
+	// Error codes found in the API error response
+	Codes []string
+	// Matched against the summaries of the whole "caused by" chain
+	Message *regexp.Regexp
+}
+
+// ProblemHint holds the remediation of the problems matching its signature.
+type ProblemHint struct {
+	Name        string
+	Remediation string
+	DocURL      string
+	Signature   ProblemSignature
+}
+
+var problemCatalog struct {
+	sync.RWMutex
+	hints []*ProblemHint
+}
+
+func init() {
+	RegisterProblemHint(&ProblemHint{
+		Name:        "expired-token",
+		Remediation: "The IAM token used by the provider has expired. Check the clock of the machine running Terraform, and when the provider is configured with iam_token and iam_refresh_token, generate new tokens or use an API key instead.",
+		DocURL:      "https://cloud.ibm.com/docs/account?topic=account-iamtoken_from_apikey",
+		Signature: ProblemSignature{
+			StatusCodes: []int{401},
+			Message:     regexp.MustCompile(`(?i)\bexpired?\b`),
+		},
+	})
+	RegisterProblemHint(&ProblemHint{
+		Name:        "iam-forbidden",
+		Remediation: "The identity used by the provider is not authorized to perform this operation. Check that the IAM access policies of the user, service ID or trusted profile grant the required platform and service roles on the target resource, resource group and account.",
+		DocURL:      "https://cloud.ibm.com/docs/account?topic=account-assign-access-resources",
+		Signature: ProblemSignature{
+			StatusCodes: []int{403},
+		},
+	})
+	RegisterProblemHint(&ProblemHint{
+		Name:        "vpc-quota-exceeded",
+		Remediation: "A VPC quota of the account has been reached in this region. Delete unused resources, or open a support case to request a quota increase.",
+		DocURL:      "https://cloud.ibm.com/docs/vpc?topic=vpc-quotas",
+		Signature: ProblemSignature{
+			Components: []string{"github.com/IBM/vpc-go-sdk"},
+			Codes:      []string{"over_quota", "quota_exceeded"},
+			Message:    regexp.MustCompile(`(?i)\bquota\b`),
+		},
+	})
+	RegisterProblemHint(&ProblemHint{
+		Name:        "resource-group-not-found",
+		Remediation: "The resource group could not be found. Check the resource group ID and that it belongs to the account of the API key, for example with the ibm_resource_group data source.",
+		DocURL:      "https://cloud.ibm.com/docs/account?topic=account-rgs",
+		Signature: ProblemSignature{
+			Message: regexp.MustCompile(`(?i)resource[ _-]group\b.*\bnot (be )?found|could not find.*resource[ _-]group`),
+		},
+	})
+	RegisterProblemHint(&ProblemHint{
+		Name:        "zone-capacity",
+		Remediation: "The zone does not have enough capacity for the requested profile right now. Retry later, or use another zone or profile.",
+		DocURL:      "https://cloud.ibm.com/docs/vpc?topic=vpc-profiles",
+		Signature: ProblemSignature{
+			Codes:   []string{"insufficient_capacity", "instance_capacity_unavailable"},
+			Message: regexp.MustCompile(`(?i)(insufficient|not enough|out of|no available) capacity|capacity (is )?(exceeded|unavailable)`),
+		},
+	})
+}
+
+// RegisterProblemHint adds hint to the problem catalog. Hints are looked up in
+// the order they were registered, and the first matching hint wins.
+func RegisterProblemHint(hint *ProblemHint) {
+	problemCatalog.Lock()
+	defer problemCatalog.Unlock()
+	problemCatalog.hints = append(problemCatalog.hints, hint)
+}
+
+// GetHint returns the hint of the problem catalog matching the problem, or nil
+// if the problem is not a known one.
+func (e *TerraformProblem) GetHint() *ProblemHint {
+	facts := e.getFacts()
+
+	problemCatalog.RLock()
+	defer problemCatalog.RUnlock()
+	for _, hint := range problemCatalog.hints {
+		if hint.Signature.matches(facts) {
+			return hint
+		}
+	}
+	return nil
+}
+
+// GetRemediation returns the remediation text and documentation link of the
+// problem, or an empty string if the problem is not a known one.
+func (e *TerraformProblem) GetRemediation() string {
+	hint := e.GetHint()
+	if hint == nil {
+		return ""
+	}
+
+	remediation := fmt.Sprintf("Remediation: %s", hint.Remediation)
+	if hint.DocURL != "" {
+		remediation += fmt.Sprintf("\nDocumentation: %s", hint.DocURL)
+	}
+	return remediation
+}
+
+// GetConsoleDetail returns the console message of the problem, followed by
+// its remediation when the problem is a known one.
+func (e *TerraformProblem) GetConsoleDetail() string {
+	detail := e.GetConsoleMessage()
+	if remediation := e.GetRemediation(); remediation != "" {
+		detail += "\n" + remediation
+	}
+	return detail
+}
+
+// problemFacts holds the properties of a problem that signatures match on.
+type problemFacts struct {
+	statusCode int
+	component  string
+	codes      []string
+	message    string
+}
+
+func (e *TerraformProblem) getFacts() problemFacts {
+	facts := problemFacts{}
+	summaries := []string{e.Summary}
+	for _, cause := range problemCauses(e.IBMProblem) {
+		summaries = append(summaries, cause.Summary)
+	}
+	facts.message = strings.Join(summaries, "\n")
+
+	var httpProblem *core.HTTPProblem
+	var requestFailure interface {
+		StatusCode() int
+		Code() string
+	}
+	if errors.As(e, &httpProblem) {
+		facts.component = componentName(httpProblem.Component)
+		if httpProblem.Response != nil {
+			facts.statusCode = httpProblem.Response.GetStatusCode()
+			facts.codes = responseErrorCodes(httpProblem.Response.GetResult())
+		}
+	} else if errors.As(e, &requestFailure) {
+		// Errors of the bluemix-go based clients
+		facts.statusCode = requestFailure.StatusCode()
+		facts.codes = []string{requestFailure.Code()}
+	}
+
+	return facts
+}
+
+// responseErrorCodes returns the error codes of an API error response, which
+// are either a list of errors or a single top-level code.
+func responseErrorCodes(result interface{}) []string {
+	body, ok := result.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var codes []string
+	if errs, ok := body["errors"].([]interface{}); ok {
+		for _, e := range errs {
+			if e, ok := e.(map[string]interface{}); ok {
+				if code, ok := e["code"].(string); ok {
+					codes = append(codes, code)
+				}
+			}
+		}
+	}
+	for _, key := range []string{"code", "errorCode"} {
+		if code, ok := body[key].(string); ok {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+func (s ProblemSignature) matches(facts problemFacts) bool {
+	if len(s.StatusCodes) > 0 && !contains(s.StatusCodes, facts.statusCode) {
+		return false
+	}
+	if len(s.Components) > 0 && !StringContains(s.Components, facts.component) {
+		return false
+	}
+
+	codeMatches := false
+	for _, code := range facts.codes {
+		codeMatches = codeMatches || StringContains(s.Codes, code)
+	}
+	messageMatches := s.Message != nil && s.Message.MatchString(facts.message)
+	if len(s.Codes) > 0 || s.Message != nil {
+		return codeMatches || messageMatches
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProblemCatalog(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		hint string
+	}{
+		{
+			name: "vpc quota",
+			err:  apiError(t, http.StatusBadRequest, `{"errors":[{"code":"over_quota","message":"The request exceeds the account limits"}]}`, "github.com/IBM/vpc-go-sdk"),
+			hint: "vpc-quota-exceeded",
+		},
+		{
+			name: "quota of another service",
+			err:  apiError(t, http.StatusBadRequest, `{"errors":[{"code":"over_quota","message":"Quota exceeded"}]}`, "github.com/IBM/platform-services-go-sdk"),
+		},
+		{
+			name: "forbidden",
+			err:  apiError(t, http.StatusForbidden, `{"errors":[{"code":"forbidden","message":"You are not authorized"}]}`, "github.com/IBM/vpc-go-sdk"),
+			hint: "iam-forbidden",
+		},
+		{
+			name: "expired token",
+			err:  apiError(t, http.StatusUnauthorized, `{"errors":[{"code":"not_authorized","message":"The token is expired"}]}`, "github.com/IBM/vpc-go-sdk"),
+			hint: "expired-token",
+		},
+		{
+			name: "zone capacity",
+			err:  apiError(t, http.StatusServiceUnavailable, `{"errors":[{"code":"internal_error","message":"Insufficient capacity in zone us-south-1"}]}`, "github.com/IBM/vpc-go-sdk"),
+			hint: "zone-capacity",
+		},
+		{
+			name: "legacy client",
+			err:  bmxerror.NewRequestFailure("Forbidden", "The user is not authorized", http.StatusForbidden),
+			hint: "iam-forbidden",
+		},
+		{
+			name: "native error",
+			err:  errors.New("Error retrieving resource group: the resource group 1a2b could not be found"),
+			hint: "resource-group-not-found",
+		},
+		{
+			name: "unknown",
+			err:  apiError(t, http.StatusNotFound, `{"errors":[{"code":"not_found","message":"VPC not found"}]}`, "github.com/IBM/vpc-go-sdk"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			problem := TerraformErrorf(test.err, "Operation failed", "ibm_some_resource", "create")
+			hint := problem.GetHint()
+			if test.hint == "" {
+				assert.Nil(t, hint)
+				assert.Empty(t, problem.GetRemediation())
+				assert.Equal(t, problem.GetConsoleMessage(), problem.GetConsoleDetail())
+				return
+			}
+			if assert.NotNil(t, hint) {
+				assert.Equal(t, test.hint, hint.Name)
+				assert.Contains(t, problem.GetConsoleDetail(), "Remediation: "+hint.Remediation)
+				assert.Contains(t, problem.GetConsoleDetail(), "Documentation: "+hint.DocURL)
+				assert.Equal(t, problem.GetRemediation(), problem.GetDiag()[0].Detail)
+			}
+		})
+	}
+}
+
+func TestRegisterProblemHint(t *testing.T) {
+	hint := &ProblemHint{
+		Name:        "custom",
+		Remediation: "Do something else.",
+		Signature: ProblemSignature{
+			StatusCodes: []int{http.StatusConflict},
+			Message:     regexp.MustCompile(`already exists`),
+		},
+	}
+	RegisterProblemHint(hint)
+	defer func() {
+		problemCatalog.hints = problemCatalog.hints[:len(problemCatalog.hints)-1]
+	}()
+
+	problem := TerraformErrorf(bmxerror.NewRequestFailure("Conflict", "The key already exists", http.StatusConflict), "", "ibm_some_resource", "create")
+	assert.Equal(t, hint, problem.GetHint())
+	assert.Equal(t, "Remediation: Do something else.", problem.GetRemediation())
+}
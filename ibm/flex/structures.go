@@ -44,6 +44,7 @@ import (
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -1615,6 +1616,15 @@ func filterResourceKeyParameters(params map[string]interface{}) map[string]inter
 	return params
 }
 
+// IdParts returns the parts of the composite ID of a resource.
+//
+// A composite ID identifies a resource by the IDs of the objects it belongs
+// to, from the outermost to the innermost, joined by "/", such as
+// "<instance_id>/<key_id>". The import section of the documentation of a
+// resource gives the parts of its ID in this order. The parts cannot contain
+// "/", so the resources whose parts may contain one, such as a CRN, separate
+// them by ":" and use SepIdParts instead. New resources build their composite
+// ID with CompositeId, and check the ID given on import with ImportIdParts.
 func IdParts(id string) ([]string, error) {
 	if strings.Contains(id, "/") {
 		parts := strings.Split(id, "/")
@@ -1623,6 +1633,28 @@ func IdParts(id string) ([]string, error) {
 	return []string{}, fmt.Errorf("The given id %s does not contain / please check documentation on how to provider id during import command", id)
 }
 
+// CompositeId returns the composite ID of the given parts, see IdParts.
+func CompositeId(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// ImportIdParts returns the parts of the composite ID given on the import of
+// a resource, which must have a non empty part for each of the names, in the
+// form "<name_1>/<name_2>/...", see IdParts.
+func ImportIdParts(id string, names ...string) ([]string, error) {
+	parts := strings.Split(id, "/")
+	valid := len(parts) == len(names)
+	for _, part := range parts {
+		valid = valid && part != ""
+	}
+	if !valid {
+		return nil, fmt.Errorf("The given id %s must have the form <%s>, please check documentation on how to provide the id during import command", id, strings.Join(names, ">/<"))
+	}
+	return parts, nil
+}
+
+// SepIdParts returns the parts of a composite ID, which are separated by
+// separator instead of "/", see IdParts.
 func SepIdParts(id string, separator string) ([]string, error) {
 	if strings.Contains(id, separator) {
 		parts := strings.Split(id, separator)
@@ -2444,6 +2476,31 @@ func Parse(s string) (CRN, error) {
 
 	return crn, nil
 }
+
+// ScopeSegment returns the scope segment of the CRN, such as a/<account ID>.
+func (c CRN) ScopeSegment() string {
+	if c.ScopeType == "" {
+		return c.Scope
+	}
+	return c.ScopeType + scopeSeparator + c.Scope
+}
+
+// String returns the CRN, which Parse parses back.
+func (c CRN) String() string {
+	return strings.Join([]string{
+		c.Scheme,
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Region,
+		c.ScopeSegment(),
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}, crnSeparator)
+}
+
 func GetLocationV2(instance rc.ResourceInstance) string {
 	crn, err := Parse(*instance.CRN)
 	if err != nil {
@@ -2934,6 +2991,70 @@ func ResourcePowerUserTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 	}
 	return nil
 }
+
+// MergeDefaultTags returns the union of the configured tags and the provider
+// level default tags. Tags are compared case-insensitively, and a tag that is
+// configured on the resource takes precedence over the default one.
+func MergeDefaultTags(tags, defaultTags []string) []string {
+	merged := make([]string, 0, len(tags)+len(defaultTags))
+	seen := make(map[string]bool, len(tags)+len(defaultTags))
+	for _, list := range [][]string{tags, defaultTags} {
+		for _, tag := range list {
+			tag = strings.TrimSpace(tag)
+			if tag == "" || seen[strings.ToLower(tag)] {
+				continue
+			}
+			seen[strings.ToLower(tag)] = true
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
+
+// ResourceDefaultTagsCustomizeDiff merges the provider level default tags into
+// the planned value of the tag attribute identified by key, so that the plan
+// shows the effective tags of the resource and tags that are set both on the
+// resource and in the provider block do not produce a diff.
+// optionalComputed reports whether the attribute was declared as
+// Optional+Computed by the resource, in which case an omitted attribute keeps
+// its prior value when there are no default tags.
+func ResourceDefaultTagsCustomizeDiff(diff *schema.ResourceDiff, key string, defaultTags []string, optionalComputed bool) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	configured := rawConfig.GetAttr(key)
+	if !configured.IsWhollyKnown() {
+		return diff.SetNewComputed(key)
+	}
+	if configured.IsNull() && optionalComputed && len(defaultTags) == 0 {
+		return nil
+	}
+
+	var tags []string
+	if !configured.IsNull() {
+		for it := configured.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			if !v.IsNull() && v.Type() == cty.String {
+				tags = append(tags, v.AsString())
+			}
+		}
+	}
+	effective := MergeDefaultTags(tags, defaultTags)
+
+	o, _ := diff.GetChange(key)
+	if oldSet, ok := o.(*schema.Set); ok {
+		newSet := schema.NewSet(oldSet.F, FlattenStringList(effective))
+		if oldSet.Equal(newSet) {
+			if diff.HasChange(key) {
+				return diff.Clear(key)
+			}
+			return nil
+		}
+	}
+	return diff.SetNew(key, effective)
+}
+
 func OnlyInUpdateDiff(resources []string, diff *schema.ResourceDiff) error {
 	for _, r := range resources {
 		if diff.HasChange(r) && diff.Id() == "" {
@@ -1,11 +1,16 @@
 package flex
 
 import (
+	"context"
+	"sort"
 	"testing"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -84,3 +89,99 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	assert.Equal(t, []string{}, MergeDefaultTags(nil, nil))
+	assert.Equal(t, []string{"env:dev"}, MergeDefaultTags(nil, []string{"env:dev"}))
+	assert.Equal(t, []string{"owner:team", "env:dev"}, MergeDefaultTags([]string{"owner:team"}, []string{"env:dev"}))
+
+	// Tags configured on the resource take precedence and are not duplicated
+	assert.Equal(t, []string{"Env:Dev", "owner:team"}, MergeDefaultTags([]string{"Env:Dev"}, []string{"env:dev", "owner:team"}))
+	assert.Equal(t, []string{"env:dev"}, MergeDefaultTags([]string{" env:dev ", ""}, []string{"env:dev"}))
+}
+
+// planDefaultTags returns the planned tags of a resource whose tags are
+// stateTags, or which does not exist yet when stateTags is nil, for the
+// configured tags and the provider level default tags.
+func planDefaultTags(t *testing.T, stateTags, configTags, defaultTags []string) []string {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return ResourceDefaultTagsCustomizeDiff(diff, "tags", defaultTags, false)
+		},
+	}
+
+	state := &terraform.InstanceState{}
+	if stateTags != nil {
+		d := r.Data(nil)
+		d.SetId("id")
+		assert.NoError(t, d.Set("tags", stateTags))
+		state = d.State()
+	}
+	rawConfig := cty.NullVal(cty.Set(cty.String))
+	config := map[string]interface{}{}
+	if configTags != nil {
+		values := []cty.Value{}
+		tags := []interface{}{}
+		for _, tag := range configTags {
+			values = append(values, cty.StringVal(tag))
+			tags = append(tags, tag)
+		}
+		rawConfig = cty.SetVal(values)
+		config["tags"] = tags
+	}
+	state.RawConfig = cty.ObjectVal(map[string]cty.Value{"tags": rawConfig})
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+	planned := ExpandStringList(d.Get("tags").(*schema.Set).List())
+	sort.Strings(planned)
+	return planned
+}
+
+func TestResourceDefaultTagsCustomizeDiff(t *testing.T) {
+	// Default tags are merged into the configured tags of a new resource
+	assert.Equal(t, []string{"env:dev", "owner:team"}, planDefaultTags(t, nil, []string{"owner:team"}, []string{"env:dev"}))
+	assert.Equal(t, []string{"env:dev"}, planDefaultTags(t, nil, nil, []string{"env:dev"}))
+
+	// A configured tag overrides the default tag differing only in case
+	assert.Equal(t, []string{"Env:Prod"}, planDefaultTags(t, nil, []string{"Env:Prod"}, []string{"env:prod"}))
+
+	// An unchanged resource has no diff
+	assert.Equal(t, []string{"env:dev", "owner:team"}, planDefaultTags(t, []string{"env:dev", "owner:team"}, []string{"owner:team"}, []string{"env:dev"}))
+
+	// Removing a default tag from the provider removes it from the resource
+	assert.Equal(t, []string{"owner:team"}, planDefaultTags(t, []string{"env:dev", "owner:team"}, []string{"owner:team"}, nil))
+	assert.Equal(t, []string{}, planDefaultTags(t, []string{"env:dev"}, nil, nil))
+
+	// A new default tag is added to an existing resource
+	assert.Equal(t, []string{"cost:1", "env:dev"}, planDefaultTags(t, []string{"env:dev"}, nil, []string{"env:dev", "cost:1"}))
+}
+
+func TestCompositeId(t *testing.T) {
+	id := CompositeId("instance", "key")
+	assert.Equal(t, "instance/key", id)
+
+	parts, err := IdParts(id)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"instance", "key"}, parts)
+
+	parts, err = ImportIdParts(id, "instance_id", "key_id")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"instance", "key"}, parts)
+
+	for _, invalid := range []string{"instance", "instance/key/version", "instance/", "/key"} {
+		_, err = ImportIdParts(invalid, "instance_id", "key_id")
+		assert.EqualError(t, err, "The given id "+invalid+" must have the form <instance_id>/<key_id>, please check documentation on how to provide the id during import command")
+	}
+}
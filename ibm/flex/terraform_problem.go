@@ -63,8 +63,13 @@ func (e *TerraformProblem) GetDebugOrderedMaps() *core.OrderedMaps {
 // GetDiag returns a new Diagnostics object using the console
 // message as the summary. It is used to create a Diagnostics
 // object from a TerraformProblem in the resource/data source code.
+// The problem is also recorded in the diagnostics file, if configured,
+// and the remediation of known problems is used as the detail.
 func (e *TerraformProblem) GetDiag() diag.Diagnostics {
-	return diag.Errorf("%s", e.GetConsoleMessage())
+	WriteDiagnostic(e)
+	diags := diag.Errorf("%s", e.GetConsoleMessage())
+	diags[0].Detail = e.GetRemediation()
+	return diags
 }
 
 // TerraformErrorf creates and returns a new instance of `TerraformProblem`
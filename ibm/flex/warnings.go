@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Warnings reported by the resource code for the resources being processed by
+// the provider, keyed by their ResourceData, from CollectWarnings until
+// PopWarnings returns them.
+var resourceWarnings struct {
+	sync.Mutex
+	diags map[*schema.ResourceData]diag.Diagnostics
+}
+
+// CollectWarnings starts collecting the warnings reported about the resource d,
+// until PopWarnings returns them. The provider calls it before each operation
+// on a resource or data source.
+func CollectWarnings(d *schema.ResourceData) {
+	resourceWarnings.Lock()
+	defer resourceWarnings.Unlock()
+	if resourceWarnings.diags == nil {
+		resourceWarnings.diags = make(map[*schema.ResourceData]diag.Diagnostics)
+	}
+	if _, ok := resourceWarnings.diags[d]; !ok {
+		resourceWarnings.diags[d] = diag.Diagnostics{}
+	}
+}
+
+// AddWarning reports a warning about the resource d, for a degraded but not
+// failed operation. The warning is logged, and returned to Terraform along
+// with the result of the current operation, so that users see it without
+// TF_LOG. Warnings about a resource whose warnings are not collected, such as
+// the ones reported from importers or tests, are only logged.
+func AddWarning(d *schema.ResourceData, summary, detail string) {
+	log.Printf("[WARN] %s", summary)
+	if d == nil {
+		return
+	}
+
+	resourceWarnings.Lock()
+	defer resourceWarnings.Unlock()
+	if _, ok := resourceWarnings.diags[d]; !ok {
+		return
+	}
+	for _, warning := range resourceWarnings.diags[d] {
+		if warning.Summary == summary && warning.Detail == detail {
+			return
+		}
+	}
+	resourceWarnings.diags[d] = append(resourceWarnings.diags[d], diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+// AddWarningf reports a warning about the resource d, see AddWarning, using
+// the formatted message as the summary.
+func AddWarningf(d *schema.ResourceData, format string, a ...interface{}) {
+	AddWarning(d, strings.TrimSpace(fmt.Sprintf(format, a...)), "")
+}
+
+// PopWarnings returns the warnings reported about the resource d, and stops
+// collecting them.
+func PopWarnings(d *schema.ResourceData) diag.Diagnostics {
+	resourceWarnings.Lock()
+	defer resourceWarnings.Unlock()
+	warnings := resourceWarnings.diags[d]
+	delete(resourceWarnings.diags, d)
+	return warnings
+}
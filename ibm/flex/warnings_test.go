@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddWarning(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}}
+	d := resource.TestResourceData()
+	other := resource.TestResourceData()
+
+	CollectWarnings(d)
+	AddWarningf(d, "Error on update of resource (%s) tags: %s\n", "r006-1", "forbidden")
+	AddWarningf(d, "Error on update of resource (%s) tags: %s\n", "r006-1", "forbidden")
+	AddWarning(d, "Only one value is used", "The other values are ignored.")
+	AddWarning(nil, "Not attached to a resource", "")
+
+	warnings := PopWarnings(d)
+	if assert.Len(t, warnings, 2) {
+		assert.Equal(t, diag.Diagnostic{Severity: diag.Warning, Summary: "Error on update of resource (r006-1) tags: forbidden"}, warnings[0])
+		assert.Equal(t, diag.Diagnostic{Severity: diag.Warning, Summary: "Only one value is used", Detail: "The other values are ignored."}, warnings[1])
+	}
+	assert.Empty(t, PopWarnings(d))
+	assert.Empty(t, PopWarnings(other))
+
+	// warnings reported outside of an operation of the provider are not kept
+	AddWarning(d, "Reported from an importer", "")
+	AddWarning(other, "Reported from a test", "")
+	assert.NotContains(t, resourceWarnings.diags, d)
+	assert.NotContains(t, resourceWarnings.diags, other)
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package importer generates the Terraform import blocks of the existing
+// objects of an account, so that they can be brought under management with
+// `terraform plan -generate-config-out`.
+package importer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Item is an existing object, which can be imported as a resource.
+type Item struct {
+	// The type of the resource, such as ibm_is_vpc
+	ResourceType string
+	// The import ID of the resource, as documented by its import section
+	ID string
+	// The name of the object, from which the name of the resource is made
+	Name string
+}
+
+// Lister lists the existing objects of a resource type. meta is the client
+// session of the provider, and options are the filters given to the
+// generator, such as the resource group ID or the CIS instance.
+type Lister func(meta interface{}, options map[string]string) ([]Item, error)
+
+// Generate lists the objects of the given resource types, or of all the
+// types of listers when none is given, and writes their import blocks to w.
+func Generate(w io.Writer, meta interface{}, listers map[string]Lister, resourceTypes []string, options map[string]string) error {
+	if len(resourceTypes) == 0 {
+		resourceTypes = ResourceTypes(listers)
+	}
+
+	var items []Item
+	for _, resourceType := range resourceTypes {
+		lister, ok := listers[resourceType]
+		if !ok {
+			return fmt.Errorf("[ERROR] The resource type %s cannot be listed, the supported types are %s", resourceType, strings.Join(ResourceTypes(listers), ", "))
+		}
+		listed, err := lister(meta, options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the objects of %s: %s", resourceType, err)
+		}
+		items = append(items, listed...)
+	}
+	return WriteImportBlocks(w, items)
+}
+
+// ResourceTypes returns the sorted resource types of listers.
+func ResourceTypes(listers map[string]Lister) []string {
+	resourceTypes := make([]string, 0, len(listers))
+	for resourceType := range listers {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
+
+// WriteImportBlocks writes an import block for each item, to a resource
+// named after the item, which is unique among the items of its type.
+func WriteImportBlocks(w io.Writer, items []Item) error {
+	used := make(map[string]bool)
+	for _, item := range items {
+		name := ResourceName(item.Name)
+		address := item.ResourceType + "." + name
+		for i := 2; used[address]; i++ {
+			address = fmt.Sprintf("%s.%s_%d", item.ResourceType, name, i)
+		}
+		used[address] = true
+
+		_, err := fmt.Fprintf(w, "import {\n  to = %s\n  id = %s\n}\n\n", address, quote(item.ID))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResourceName returns a valid Terraform resource name for the name of an
+// object: letters, digits, underscores and dashes, starting with a letter
+// or an underscore.
+func ResourceName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	resourceName := b.String()
+	if resourceName == "" || (resourceName[0] >= '0' && resourceName[0] <= '9') || resourceName[0] == '-' {
+		resourceName = "r_" + resourceName
+	}
+	return resourceName
+}
+
+// quote returns s as a quoted HCL string, in which the template sequences
+// are escaped.
+func quote(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return `"` + replacer.Replace(s) + `"`
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceName(t *testing.T) {
+	testCases := map[string]string{
+		"my-vpc":          "my-vpc",
+		"My VPC":          "my_vpc",
+		"db.example.com":  "db_example_com",
+		"10-0-0-0-subnet": "r_10-0-0-0-subnet",
+		"-edge":           "r_-edge",
+		"":                "r_",
+		"_private":        "_private",
+	}
+	for name, expected := range testCases {
+		assert.Equal(t, expected, ResourceName(name), name)
+	}
+}
+
+func TestWriteImportBlocks(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteImportBlocks(&buf, []Item{
+		{ResourceType: "ibm_is_subnet", ID: "0717-a", Name: "web"},
+		{ResourceType: "ibm_is_subnet", ID: "0717-b", Name: "Web"},
+		{ResourceType: "ibm_is_vpc", ID: "r006-c", Name: "web"},
+		{ResourceType: "ibm_is_subnet", ID: "0717-d", Name: "web"},
+		{ResourceType: "ibm_cis_dns_record", ID: `a:b:"${c}"`, Name: "www.example.com"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `import {
+  to = ibm_is_subnet.web
+  id = "0717-a"
+}
+
+import {
+  to = ibm_is_subnet.web_2
+  id = "0717-b"
+}
+
+import {
+  to = ibm_is_vpc.web
+  id = "r006-c"
+}
+
+import {
+  to = ibm_is_subnet.web_3
+  id = "0717-d"
+}
+
+import {
+  to = ibm_cis_dns_record.www_example_com
+  id = "a:b:\"$${c}\""
+}
+
+`, buf.String())
+}
+
+func TestGenerate(t *testing.T) {
+	listers := map[string]Lister{
+		"ibm_is_vpc": func(meta interface{}, options map[string]string) ([]Item, error) {
+			return []Item{{ResourceType: "ibm_is_vpc", ID: "r006-a", Name: options["resource_group_id"]}}, nil
+		},
+		"ibm_is_subnet": func(meta interface{}, options map[string]string) ([]Item, error) {
+			return []Item{{ResourceType: "ibm_is_subnet", ID: "0717-b", Name: "subnet"}}, nil
+		},
+		"ibm_is_volume": func(meta interface{}, options map[string]string) ([]Item, error) {
+			return nil, errors.New("forbidden")
+		},
+	}
+	options := map[string]string{"resource_group_id": "default"}
+
+	var buf bytes.Buffer
+	err := Generate(&buf, nil, listers, []string{"ibm_is_vpc"}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, "import {\n  to = ibm_is_vpc.default\n  id = \"r006-a\"\n}\n\n", buf.String())
+
+	buf.Reset()
+	err = Generate(&buf, nil, listers, []string{"ibm_is_subnet", "ibm_is_vpc"}, options)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "ibm_is_subnet.subnet")
+	assert.Contains(t, buf.String(), "ibm_is_vpc.default")
+
+	err = Generate(&buf, nil, listers, []string{"ibm_is_instance"}, options)
+	assert.EqualError(t, err, "[ERROR] The resource type ibm_is_instance cannot be listed, the supported types are ibm_is_subnet, ibm_is_volume, ibm_is_vpc")
+
+	err = Generate(&buf, nil, listers, nil, options)
+	assert.EqualError(t, err, "[ERROR] Error listing the objects of ibm_is_volume: forbidden")
+}
@@ -0,0 +1,476 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package netpath evaluates offline whether a flow between two endpoints of a
+// VPC is allowed by their security groups, by the network ACLs of their
+// subnets and by the routing table of the source subnet.
+//
+// The source and destination of a flow may be CIDR blocks, and its ports may
+// be unknown, so the evaluation is conservative: a rule allows the flow when
+// it allows every address and port of the flow, and a rule denies the flow
+// when it denies any of them.
+package netpath
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// The protocols of flows and rules
+const (
+	ProtocolAll  = "all"
+	ProtocolICMP = "icmp"
+	ProtocolTCP  = "tcp"
+	ProtocolUDP  = "udp"
+)
+
+// The directions of rules
+const (
+	DirectionInbound  = "inbound"
+	DirectionOutbound = "outbound"
+)
+
+// The actions of network ACL rules
+const (
+	ActionAllow = "allow"
+	ActionDeny  = "deny"
+)
+
+// The actions of routes
+const (
+	RouteActionDelegate    = "delegate"
+	RouteActionDelegateVPC = "delegate_vpc"
+	RouteActionDeliver     = "deliver"
+	RouteActionDrop        = "drop"
+)
+
+// The names of the hops of a flow, in the order they are evaluated. The
+// network ACLs are stateless, so the response of the flow is evaluated
+// against them too.
+const (
+	HopSourceSecurityGroups          = "source_security_groups"
+	HopSourceNetworkACL              = "source_network_acl"
+	HopSourceRoutingTable            = "source_routing_table"
+	HopDestinationNetworkACL         = "destination_network_acl"
+	HopDestinationSecurityGroups     = "destination_security_groups"
+	HopDestinationNetworkACLResponse = "destination_network_acl_response"
+	HopSourceNetworkACLResponse      = "source_network_acl_response"
+)
+
+// The ICMP types of an echo request and of its reply
+const (
+	icmpEchoRequest = 8
+	icmpEchoReply   = 0
+)
+
+// PortRange is an inclusive range of ports. The zero value is all ports.
+type PortRange struct {
+	Min, Max int
+}
+
+// AllPorts is the range of all the ports.
+var AllPorts = PortRange{Min: 1, Max: 65535}
+
+// EphemeralPorts is the range of the source ports of tcp and udp flows whose
+// source port is unknown. Clients pick their source port in this range, so the
+// response of the flow goes back to it.
+var EphemeralPorts = PortRange{Min: 1024, Max: 65535}
+
+func (r PortRange) normalize() PortRange {
+	if r == (PortRange{}) {
+		return AllPorts
+	}
+	return r
+}
+
+func (r PortRange) contains(o PortRange) bool {
+	r, o = r.normalize(), o.normalize()
+	return r.Min <= o.Min && o.Max <= r.Max
+}
+
+func (r PortRange) overlaps(o PortRange) bool {
+	r, o = r.normalize(), o.normalize()
+	return r.Min <= o.Max && o.Min <= r.Max
+}
+
+// SecurityGroupRule is a rule of a security group, which allows the matching
+// traffic.
+type SecurityGroupRule struct {
+	ID        string
+	Direction string
+	Protocol  string
+	// Remote is an IP address, a CIDR block or a security group ID. Empty
+	// matches any address.
+	Remote string
+	// Local is an IP address or a CIDR block. Empty matches any address.
+	Local string
+	// Ports of the tcp and udp rules
+	Ports PortRange
+	// ICMPType and ICMPCode of the icmp rules, nil for any
+	ICMPType, ICMPCode *int
+}
+
+// SecurityGroup is a security group, whose rules are evaluated together.
+type SecurityGroup struct {
+	ID    string
+	Name  string
+	Rules []SecurityGroupRule
+}
+
+// NetworkACLRule is a rule of a network ACL.
+type NetworkACLRule struct {
+	ID          string
+	Name        string
+	Action      string
+	Direction   string
+	Protocol    string
+	Source      string
+	Destination string
+	// DestinationPorts and SourcePorts of the tcp and udp rules
+	DestinationPorts, SourcePorts PortRange
+	// ICMPType and ICMPCode of the icmp rules, nil for any
+	ICMPType, ICMPCode *int
+}
+
+// NetworkACL is a network ACL, whose rules are evaluated in order.
+type NetworkACL struct {
+	ID    string
+	Name  string
+	Rules []NetworkACLRule
+}
+
+// Route is a route of a routing table.
+type Route struct {
+	ID          string
+	Name        string
+	Zone        string
+	Destination string
+	Action      string
+	NextHop     string
+	Priority    int
+}
+
+// RoutingTable is a routing table.
+type RoutingTable struct {
+	ID     string
+	Name   string
+	Routes []Route
+}
+
+// Subnet is a subnet, with its network ACL and routing table.
+type Subnet struct {
+	ID           string
+	Name         string
+	Zone         string
+	NetworkACL   *NetworkACL
+	RoutingTable *RoutingTable
+}
+
+// Endpoint is the source or the destination of a flow.
+type Endpoint struct {
+	// Prefix is the address or the CIDR block of the endpoint. The address of
+	// an instance, a virtual network interface or a reserved IP is a /32.
+	Prefix netip.Prefix
+	// Subnet is the subnet of the endpoint, nil outside of the VPC
+	Subnet *Subnet
+	// SecurityGroups are the security groups of the endpoint, none for a CIDR
+	// block
+	SecurityGroups []SecurityGroup
+}
+
+// Flow is the traffic from the source to the destination.
+type Flow struct {
+	Protocol string
+	// DestinationPorts and SourcePorts of tcp and udp flows. The zero value,
+	// for an unknown port, is all ports for DestinationPorts and
+	// EphemeralPorts for SourcePorts.
+	DestinationPorts, SourcePorts PortRange
+	// ICMPType and ICMPCode of icmp flows, nil when unknown
+	ICMPType, ICMPCode *int
+}
+
+// Hop is the evaluation of a flow by a security group, a network ACL or a
+// routing table.
+type Hop struct {
+	Name string
+	// ResourceID is the ID of the security group, network ACL or routing table.
+	// For security groups denying the flow, it is the comma-separated IDs of
+	// all the security groups of the endpoint.
+	ResourceID string
+	Allowed    bool
+	// RuleID and RuleName identify the rule or route that allows or denies the
+	// flow, and are empty when the flow is denied because no rule allows it
+	RuleID   string
+	RuleName string
+	Reason   string
+}
+
+// Result is the evaluation of a flow.
+type Result struct {
+	// Allowed is true when every hop allows the flow
+	Allowed bool
+	Hops    []Hop
+}
+
+// Evaluate evaluates the flow from the source to the destination. The
+// security groups of each endpoint are evaluated when it has some, and the
+// network ACLs and the routing table are evaluated when the traffic leaves
+// or enters a subnet.
+func Evaluate(source, destination Endpoint, flow Flow) Result {
+	var hops []Hop
+	crossesSubnets := source.Subnet == nil || destination.Subnet == nil || source.Subnet.ID != destination.Subnet.ID
+	if (flow.Protocol == ProtocolTCP || flow.Protocol == ProtocolUDP) && flow.SourcePorts == (PortRange{}) {
+		flow.SourcePorts = EphemeralPorts
+	}
+	response := reverse(flow)
+
+	if len(source.SecurityGroups) > 0 {
+		hops = append(hops, evaluateSecurityGroups(HopSourceSecurityGroups, DirectionOutbound, source, destination, flow))
+	}
+	if crossesSubnets && source.Subnet != nil && source.Subnet.NetworkACL != nil {
+		hops = append(hops, evaluateNetworkACL(HopSourceNetworkACL, DirectionOutbound, source.Subnet.NetworkACL, source.Prefix, destination.Prefix, flow))
+	}
+	if crossesSubnets && source.Subnet != nil && source.Subnet.RoutingTable != nil {
+		hops = append(hops, evaluateRoutingTable(source.Subnet.RoutingTable, source.Subnet.Zone, destination.Prefix))
+	}
+	if crossesSubnets && destination.Subnet != nil && destination.Subnet.NetworkACL != nil {
+		hops = append(hops, evaluateNetworkACL(HopDestinationNetworkACL, DirectionInbound, destination.Subnet.NetworkACL, source.Prefix, destination.Prefix, flow))
+	}
+	if len(destination.SecurityGroups) > 0 {
+		hops = append(hops, evaluateSecurityGroups(HopDestinationSecurityGroups, DirectionInbound, destination, source, flow))
+	}
+	if crossesSubnets && destination.Subnet != nil && destination.Subnet.NetworkACL != nil {
+		hops = append(hops, evaluateNetworkACL(HopDestinationNetworkACLResponse, DirectionOutbound, destination.Subnet.NetworkACL, destination.Prefix, source.Prefix, response))
+	}
+	if crossesSubnets && source.Subnet != nil && source.Subnet.NetworkACL != nil {
+		hops = append(hops, evaluateNetworkACL(HopSourceNetworkACLResponse, DirectionInbound, source.Subnet.NetworkACL, destination.Prefix, source.Prefix, response))
+	}
+
+	result := Result{Allowed: true, Hops: hops}
+	for _, hop := range hops {
+		result.Allowed = result.Allowed && hop.Allowed
+	}
+	return result
+}
+
+// reverse returns the response of a flow. The response of an ICMP echo request
+// is an echo reply, and the type of the response of other ICMP flows is
+// unknown.
+func reverse(flow Flow) Flow {
+	response := Flow{
+		Protocol:         flow.Protocol,
+		DestinationPorts: flow.SourcePorts,
+		SourcePorts:      flow.DestinationPorts,
+	}
+	if flow.Protocol == ProtocolICMP && flow.ICMPType != nil && *flow.ICMPType == icmpEchoRequest {
+		reply := icmpEchoReply
+		response.ICMPType = &reply
+		response.ICMPCode = flow.ICMPCode
+	}
+	return response
+}
+
+// evaluateSecurityGroups evaluates the flow against the rules of the given
+// direction of the security groups of the endpoint. The peer is the other
+// endpoint of the flow.
+func evaluateSecurityGroups(name, direction string, endpoint, peer Endpoint, flow Flow) Hop {
+	peerGroups := make(map[string]bool, len(peer.SecurityGroups))
+	for _, group := range peer.SecurityGroups {
+		peerGroups[group.ID] = true
+	}
+	ids := make([]string, 0, len(endpoint.SecurityGroups))
+	for _, group := range endpoint.SecurityGroups {
+		ids = append(ids, group.ID)
+		for _, rule := range group.Rules {
+			if rule.Direction != direction || !protocolContains(rule.Protocol, rule.Ports, AllPorts, rule.ICMPType, rule.ICMPCode, flow) {
+				continue
+			}
+			if !addressContains(rule.Local, endpoint.Prefix) {
+				continue
+			}
+			if !addressContains(rule.Remote, peer.Prefix) && !peerGroups[rule.Remote] {
+				continue
+			}
+			return Hop{
+				Name:       name,
+				ResourceID: group.ID,
+				Allowed:    true,
+				RuleID:     rule.ID,
+				Reason:     fmt.Sprintf("allowed by %s rule %s of security group %s", direction, rule.ID, groupName(group)),
+			}
+		}
+	}
+	return Hop{
+		Name:       name,
+		ResourceID: strings.Join(ids, ","),
+		Reason:     fmt.Sprintf("no %s rule of the security groups allows the traffic", direction),
+	}
+}
+
+// evaluateNetworkACL evaluates the traffic from the source to the destination
+// against the rules of the given direction of the network ACL, in order.
+func evaluateNetworkACL(name, direction string, acl *NetworkACL, source, destination netip.Prefix, flow Flow) Hop {
+	for _, rule := range acl.Rules {
+		if rule.Direction != direction {
+			continue
+		}
+		var matches bool
+		if rule.Action == ActionDeny {
+			matches = protocolOverlaps(rule.Protocol, rule.DestinationPorts, rule.SourcePorts, rule.ICMPType, rule.ICMPCode, flow) &&
+				addressOverlaps(rule.Source, source) && addressOverlaps(rule.Destination, destination)
+		} else {
+			matches = protocolContains(rule.Protocol, rule.DestinationPorts, rule.SourcePorts, rule.ICMPType, rule.ICMPCode, flow) &&
+				addressContains(rule.Source, source) && addressContains(rule.Destination, destination)
+		}
+		if !matches {
+			continue
+		}
+		verb := "allowed"
+		if rule.Action == ActionDeny {
+			verb = "denied"
+		}
+		return Hop{
+			Name:       name,
+			ResourceID: acl.ID,
+			Allowed:    rule.Action != ActionDeny,
+			RuleID:     rule.ID,
+			RuleName:   rule.Name,
+			Reason:     fmt.Sprintf("%s by %s rule %s of network ACL %s", verb, direction, rule.Name, aclName(acl)),
+		}
+	}
+	return Hop{
+		Name:       name,
+		ResourceID: acl.ID,
+		Reason:     fmt.Sprintf("no %s rule of network ACL %s matches the traffic, which is denied", direction, aclName(acl)),
+	}
+}
+
+// evaluateRoutingTable evaluates the route of the routing table that the
+// traffic from the zone to the destination takes: the route of the zone with
+// the longest prefix containing the destination, and then the smallest
+// priority.
+func evaluateRoutingTable(table *RoutingTable, zone string, destination netip.Prefix) Hop {
+	var best *Route
+	bestBits := -1
+	for i := range table.Routes {
+		route := &table.Routes[i]
+		if route.Zone != "" && route.Zone != zone {
+			continue
+		}
+		prefix, ok := parsePrefix(route.Destination)
+		if !ok || !prefixContains(prefix, destination) {
+			continue
+		}
+		if prefix.Bits() > bestBits || (prefix.Bits() == bestBits && route.Priority < best.Priority) {
+			best, bestBits = route, prefix.Bits()
+		}
+	}
+	hop := Hop{
+		Name:       HopSourceRoutingTable,
+		ResourceID: table.ID,
+		Allowed:    true,
+	}
+	if best == nil {
+		hop.Reason = "no route matches the traffic, which follows the system routes"
+		return hop
+	}
+	hop.RuleID, hop.RuleName = best.ID, best.Name
+	switch best.Action {
+	case RouteActionDrop:
+		hop.Allowed = false
+		hop.Reason = fmt.Sprintf("dropped by route %s", best.Name)
+	case RouteActionDeliver:
+		hop.Reason = fmt.Sprintf("delivered to next hop %s by route %s", best.NextHop, best.Name)
+	default:
+		hop.Reason = fmt.Sprintf("delegated to the system routes by route %s", best.Name)
+	}
+	return hop
+}
+
+// protocolContains reports whether the protocol, ports and ICMP type and code
+// of a rule match every packet of the flow.
+func protocolContains(protocol string, destinationPorts, sourcePorts PortRange, icmpType, icmpCode *int, flow Flow) bool {
+	if protocol == ProtocolAll {
+		return true
+	}
+	if protocol != flow.Protocol {
+		return false
+	}
+	switch protocol {
+	case ProtocolTCP, ProtocolUDP:
+		return destinationPorts.contains(flow.DestinationPorts) && sourcePorts.contains(flow.SourcePorts)
+	case ProtocolICMP:
+		return (icmpType == nil || (flow.ICMPType != nil && *icmpType == *flow.ICMPType)) &&
+			(icmpCode == nil || (flow.ICMPCode != nil && *icmpCode == *flow.ICMPCode))
+	}
+	return false
+}
+
+// protocolOverlaps reports whether the protocol, ports and ICMP type and code
+// of a rule match any packet of the flow.
+func protocolOverlaps(protocol string, destinationPorts, sourcePorts PortRange, icmpType, icmpCode *int, flow Flow) bool {
+	if protocol == ProtocolAll || flow.Protocol == ProtocolAll {
+		return true
+	}
+	if protocol != flow.Protocol {
+		return false
+	}
+	switch protocol {
+	case ProtocolTCP, ProtocolUDP:
+		return destinationPorts.overlaps(flow.DestinationPorts) && sourcePorts.overlaps(flow.SourcePorts)
+	case ProtocolICMP:
+		return (icmpType == nil || flow.ICMPType == nil || *icmpType == *flow.ICMPType) &&
+			(icmpCode == nil || flow.ICMPCode == nil || *icmpCode == *flow.ICMPCode)
+	}
+	return false
+}
+
+// parsePrefix parses an IP address or a CIDR block.
+func parsePrefix(s string) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		return prefix.Masked(), true
+	}
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), true
+	}
+	return netip.Prefix{}, false
+}
+
+func prefixContains(p, o netip.Prefix) bool {
+	return p.Bits() <= o.Bits() && p.Contains(o.Addr())
+}
+
+// addressContains reports whether the IP address or CIDR block of a rule,
+// empty for any address, contains the prefix.
+func addressContains(address string, p netip.Prefix) bool {
+	if address == "" {
+		return true
+	}
+	prefix, ok := parsePrefix(address)
+	return ok && prefixContains(prefix, p)
+}
+
+// addressOverlaps reports whether the IP address or CIDR block of a rule,
+// empty for any address, overlaps the prefix.
+func addressOverlaps(address string, p netip.Prefix) bool {
+	if address == "" {
+		return true
+	}
+	prefix, ok := parsePrefix(address)
+	return ok && prefix.Overlaps(p)
+}
+
+func groupName(group SecurityGroup) string {
+	if group.Name != "" {
+		return group.Name
+	}
+	return group.ID
+}
+
+func aclName(acl *NetworkACL) string {
+	if acl.Name != "" {
+		return acl.Name
+	}
+	return acl.ID
+}
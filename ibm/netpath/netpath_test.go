@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package netpath
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+// fixture is a VPC with a web subnet and a database subnet in zone 1, whose
+// network ACL allows all traffic, and whose security groups allow the web
+// instances to reach the database instances on port 5432.
+type fixture struct {
+	web, db, onPrem Endpoint
+	acl             *NetworkACL
+	table           *RoutingTable
+}
+
+func newFixture() *fixture {
+	acl := &NetworkACL{
+		ID:   "acl-1",
+		Name: "default-acl",
+		Rules: []NetworkACLRule{
+			{ID: "acl-rule-in", Name: "allow-inbound", Action: ActionAllow, Direction: DirectionInbound, Protocol: ProtocolAll, Source: "0.0.0.0/0", Destination: "0.0.0.0/0"},
+			{ID: "acl-rule-out", Name: "allow-outbound", Action: ActionAllow, Direction: DirectionOutbound, Protocol: ProtocolAll, Source: "0.0.0.0/0", Destination: "0.0.0.0/0"},
+		},
+	}
+	table := &RoutingTable{ID: "rt-1", Name: "default-rt"}
+	webSubnet := &Subnet{ID: "subnet-web", Zone: "us-south-1", NetworkACL: acl, RoutingTable: table}
+	dbSubnet := &Subnet{ID: "subnet-db", Zone: "us-south-1", NetworkACL: acl, RoutingTable: table}
+	webGroup := SecurityGroup{
+		ID:   "sg-web",
+		Name: "web",
+		Rules: []SecurityGroupRule{
+			{ID: "sg-web-out", Direction: DirectionOutbound, Protocol: ProtocolAll, Remote: "0.0.0.0/0"},
+			{ID: "sg-web-https", Direction: DirectionInbound, Protocol: ProtocolTCP, Remote: "0.0.0.0/0", Ports: PortRange{Min: 443, Max: 443}},
+		},
+	}
+	dbGroup := SecurityGroup{
+		ID:   "sg-db",
+		Name: "db",
+		Rules: []SecurityGroupRule{
+			{ID: "sg-db-out", Direction: DirectionOutbound, Protocol: ProtocolAll},
+			{ID: "sg-db-postgres", Direction: DirectionInbound, Protocol: ProtocolTCP, Remote: "sg-web", Ports: PortRange{Min: 5432, Max: 5432}},
+			{ID: "sg-db-ping", Direction: DirectionInbound, Protocol: ProtocolICMP, Remote: "10.240.0.0/16", ICMPType: intPtr(8)},
+		},
+	}
+	return &fixture{
+		web:    Endpoint{Prefix: netip.MustParsePrefix("10.240.0.4/32"), Subnet: webSubnet, SecurityGroups: []SecurityGroup{webGroup}},
+		db:     Endpoint{Prefix: netip.MustParsePrefix("10.240.1.4/32"), Subnet: dbSubnet, SecurityGroups: []SecurityGroup{dbGroup}},
+		onPrem: Endpoint{Prefix: netip.MustParsePrefix("192.168.0.0/24")},
+		acl:    acl,
+		table:  table,
+	}
+}
+
+func hopNames(result Result) []string {
+	names := make([]string, 0, len(result.Hops))
+	for _, hop := range result.Hops {
+		names = append(names, hop.Name)
+	}
+	return names
+}
+
+func TestEvaluateAllowed(t *testing.T) {
+	f := newFixture()
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+
+	assert.True(t, result.Allowed)
+	assert.Equal(t, []string{
+		HopSourceSecurityGroups,
+		HopSourceNetworkACL,
+		HopSourceRoutingTable,
+		HopDestinationNetworkACL,
+		HopDestinationSecurityGroups,
+		HopDestinationNetworkACLResponse,
+		HopSourceNetworkACLResponse,
+	}, hopNames(result))
+	assert.Equal(t, "sg-web-out", result.Hops[0].RuleID)
+	assert.Equal(t, "acl-rule-out", result.Hops[1].RuleID)
+	assert.Equal(t, "", result.Hops[2].RuleID)
+	assert.Equal(t, "sg-db", result.Hops[4].ResourceID)
+	assert.Equal(t, "sg-db-postgres", result.Hops[4].RuleID)
+}
+
+func TestEvaluateSecurityGroupDenied(t *testing.T) {
+	f := newFixture()
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 22, Max: 22}})
+
+	assert.False(t, result.Allowed)
+	hop := result.Hops[4]
+	assert.Equal(t, HopDestinationSecurityGroups, hop.Name)
+	assert.False(t, hop.Allowed)
+	assert.Equal(t, "", hop.RuleID)
+	assert.Equal(t, "sg-db", hop.ResourceID)
+
+	// The remote security group only matches its members
+	result = Evaluate(f.onPrem, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+	assert.False(t, result.Allowed)
+}
+
+func TestEvaluateUnknownPort(t *testing.T) {
+	f := newFixture()
+	// An unknown port is allowed only by rules allowing all ports
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP})
+	assert.False(t, result.Allowed)
+
+	result = Evaluate(f.db, f.web, Flow{Protocol: ProtocolTCP})
+	assert.False(t, result.Allowed)
+	result = Evaluate(f.db, f.web, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 443, Max: 443}})
+	assert.True(t, result.Allowed)
+}
+
+func TestEvaluateICMP(t *testing.T) {
+	f := newFixture()
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolICMP, ICMPType: intPtr(8), ICMPCode: intPtr(0)})
+	assert.True(t, result.Allowed)
+	assert.Equal(t, "sg-db-ping", result.Hops[4].RuleID)
+
+	result = Evaluate(f.web, f.db, Flow{Protocol: ProtocolICMP, ICMPType: intPtr(13)})
+	assert.False(t, result.Allowed)
+
+	// An unknown ICMP type is not allowed by a rule of a given type
+	result = Evaluate(f.web, f.db, Flow{Protocol: ProtocolICMP})
+	assert.False(t, result.Allowed)
+}
+
+func TestEvaluateNetworkACLOrder(t *testing.T) {
+	f := newFixture()
+	f.acl.Rules = append([]NetworkACLRule{
+		{ID: "acl-deny-ssh", Name: "deny-ssh", Action: ActionDeny, Direction: DirectionInbound, Protocol: ProtocolTCP, Source: "0.0.0.0/0", Destination: "10.240.1.0/24", DestinationPorts: PortRange{Min: 22, Max: 22}},
+	}, f.acl.Rules...)
+	f.db.SecurityGroups[0].Rules = append(f.db.SecurityGroups[0].Rules,
+		SecurityGroupRule{ID: "sg-db-ssh", Direction: DirectionInbound, Protocol: ProtocolTCP, Ports: PortRange{Min: 22, Max: 22}})
+
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 22, Max: 22}})
+	assert.False(t, result.Allowed)
+	hop := result.Hops[3]
+	assert.Equal(t, HopDestinationNetworkACL, hop.Name)
+	assert.False(t, hop.Allowed)
+	assert.Equal(t, "acl-1", hop.ResourceID)
+	assert.Equal(t, "acl-deny-ssh", hop.RuleID)
+	assert.Equal(t, "deny-ssh", hop.RuleName)
+	// The security group of the destination still allows the traffic
+	assert.True(t, result.Hops[4].Allowed)
+
+	// A deny rule overlapping part of a CIDR block denies it
+	source := f.onPrem
+	source.Prefix = netip.MustParsePrefix("10.0.0.0/8")
+	result = Evaluate(source, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 1, Max: 1024}})
+	assert.False(t, result.Hops[0].Allowed)
+	assert.Equal(t, "acl-deny-ssh", result.Hops[0].RuleID)
+
+	// The ports after the deny rule are allowed
+	result = Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+	assert.True(t, result.Allowed)
+	assert.Equal(t, "acl-rule-in", result.Hops[3].RuleID)
+}
+
+func TestEvaluateNetworkACLImplicitDeny(t *testing.T) {
+	f := newFixture()
+	f.acl.Rules = f.acl.Rules[:1]
+
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+	assert.False(t, result.Allowed)
+	assert.False(t, result.Hops[1].Allowed)
+	assert.Equal(t, "", result.Hops[1].RuleID)
+	// The response leaves the destination subnet
+	assert.Equal(t, HopDestinationNetworkACLResponse, result.Hops[5].Name)
+	assert.False(t, result.Hops[5].Allowed)
+}
+
+func TestEvaluateNetworkACLResponse(t *testing.T) {
+	f := newFixture()
+	// The response of the database leaves from port 5432
+	f.acl.Rules = []NetworkACLRule{
+		{ID: "in", Name: "in", Action: ActionAllow, Direction: DirectionInbound, Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 1, Max: 65535}},
+		{ID: "out-postgres", Name: "out-postgres", Action: ActionAllow, Direction: DirectionOutbound, Protocol: ProtocolTCP, SourcePorts: PortRange{Min: 5432, Max: 5432}},
+		{ID: "out-client", Name: "out-client", Action: ActionAllow, Direction: DirectionOutbound, Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}},
+	}
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+	assert.True(t, result.Allowed)
+	assert.Equal(t, "out-client", result.Hops[1].RuleID)
+	assert.Equal(t, "out-postgres", result.Hops[5].RuleID)
+}
+
+func TestEvaluateNetworkACLEphemeralResponse(t *testing.T) {
+	f := newFixture()
+	// The network ACL lets the responses back only to the ephemeral ports
+	f.acl.Rules = []NetworkACLRule{
+		{ID: "in-ephemeral", Name: "in-ephemeral", Action: ActionAllow, Direction: DirectionInbound, Protocol: ProtocolTCP, SourcePorts: PortRange{Min: 5432, Max: 5432}, DestinationPorts: EphemeralPorts},
+		{ID: "in-postgres", Name: "in-postgres", Action: ActionAllow, Direction: DirectionInbound, Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}},
+		{ID: "out-ephemeral", Name: "out-ephemeral", Action: ActionAllow, Direction: DirectionOutbound, Protocol: ProtocolTCP, SourcePorts: PortRange{Min: 5432, Max: 5432}, DestinationPorts: EphemeralPorts},
+		{ID: "out-postgres", Name: "out-postgres", Action: ActionAllow, Direction: DirectionOutbound, Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}},
+	}
+	// The unset source port is an ephemeral port
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+	assert.True(t, result.Allowed)
+	assert.Equal(t, "out-postgres", result.Hops[1].RuleID)
+	assert.Equal(t, "in-postgres", result.Hops[3].RuleID)
+	assert.Equal(t, "out-ephemeral", result.Hops[5].RuleID)
+	assert.Equal(t, "in-ephemeral", result.Hops[6].RuleID)
+
+	// A source port out of the ephemeral range gets no response
+	result = Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}, SourcePorts: PortRange{Min: 1000, Max: 1000}})
+	assert.False(t, result.Allowed)
+	assert.True(t, result.Hops[1].Allowed)
+	assert.False(t, result.Hops[5].Allowed)
+	assert.Equal(t, "", result.Hops[5].RuleID)
+}
+
+func TestEvaluateRoutingTable(t *testing.T) {
+	f := newFixture()
+	f.table.Routes = []Route{
+		{ID: "r-wide", Name: "wide", Zone: "us-south-1", Destination: "10.240.0.0/16", Action: RouteActionDeliver, NextHop: "10.240.0.10", Priority: 2},
+		{ID: "r-drop", Name: "drop-db", Zone: "us-south-1", Destination: "10.240.1.0/24", Action: RouteActionDrop, Priority: 2},
+		{ID: "r-deliver", Name: "deliver-db", Zone: "us-south-1", Destination: "10.240.1.0/24", Action: RouteActionDeliver, NextHop: "10.240.0.10", Priority: 1},
+		{ID: "r-other-zone", Name: "other-zone", Zone: "us-south-2", Destination: "10.240.1.4/32", Action: RouteActionDrop},
+	}
+
+	// The longest prefix wins, and then the smallest priority
+	result := Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+	assert.True(t, result.Allowed)
+	hop := result.Hops[2]
+	assert.Equal(t, HopSourceRoutingTable, hop.Name)
+	assert.Equal(t, "rt-1", hop.ResourceID)
+	assert.Equal(t, "r-deliver", hop.RuleID)
+	assert.Contains(t, hop.Reason, "10.240.0.10")
+
+	f.table.Routes[2].Priority = 3
+	result = Evaluate(f.web, f.db, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+	assert.False(t, result.Allowed)
+	assert.Equal(t, "r-drop", result.Hops[2].RuleID)
+	assert.Equal(t, "drop-db", result.Hops[2].RuleName)
+}
+
+func TestEvaluateSameSubnet(t *testing.T) {
+	f := newFixture()
+	f.acl.Rules = nil
+	peer := f.db
+	peer.Subnet = f.web.Subnet
+	peer.Prefix = netip.MustParsePrefix("10.240.0.5/32")
+
+	// The network ACL and the routing table do not apply within a subnet
+	result := Evaluate(f.web, peer, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 5432, Max: 5432}})
+	assert.True(t, result.Allowed)
+	assert.Equal(t, []string{HopSourceSecurityGroups, HopDestinationSecurityGroups}, hopNames(result))
+}
+
+func TestEvaluateCIDR(t *testing.T) {
+	f := newFixture()
+	f.web.SecurityGroups[0].Rules[1].Remote = "192.168.0.0/16"
+
+	// A CIDR block outside of the VPC only has the hops of the other endpoint
+	result := Evaluate(f.onPrem, f.web, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 443, Max: 443}})
+	assert.True(t, result.Allowed)
+	assert.Equal(t, []string{HopDestinationNetworkACL, HopDestinationSecurityGroups, HopDestinationNetworkACLResponse}, hopNames(result))
+
+	// A rule allows a CIDR block only when it contains all of it
+	source := f.onPrem
+	source.Prefix = netip.MustParsePrefix("192.0.0.0/8")
+	result = Evaluate(source, f.web, Flow{Protocol: ProtocolTCP, DestinationPorts: PortRange{Min: 443, Max: 443}})
+	assert.False(t, result.Allowed)
+}
+
+func TestPortRange(t *testing.T) {
+	assert.True(t, PortRange{}.contains(PortRange{Min: 22, Max: 22}))
+	assert.True(t, PortRange{Min: 20, Max: 30}.contains(PortRange{Min: 22, Max: 22}))
+	assert.False(t, PortRange{Min: 20, Max: 30}.contains(PortRange{}))
+	assert.True(t, PortRange{Min: 20, Max: 30}.overlaps(PortRange{}))
+	assert.True(t, PortRange{Min: 20, Max: 30}.overlaps(PortRange{Min: 30, Max: 40}))
+	assert.False(t, PortRange{Min: 20, Max: 30}.overlaps(PortRange{Min: 31, Max: 40}))
+}
+
+func TestAddress(t *testing.T) {
+	host := netip.MustParsePrefix("10.240.0.4/32")
+	assert.True(t, addressContains("", host))
+	assert.True(t, addressContains("10.240.0.4", host))
+	assert.True(t, addressContains("10.240.0.0/24", host))
+	assert.True(t, addressContains("10.240.0.9/16", host))
+	assert.False(t, addressContains("10.240.1.0/24", host))
+	assert.False(t, addressContains("sg-web", host))
+
+	block := netip.MustParsePrefix("10.0.0.0/8")
+	assert.False(t, addressContains("10.240.0.0/16", block))
+	assert.True(t, addressOverlaps("10.240.0.0/16", block))
+	assert.False(t, addressOverlaps("192.168.0.0/16", block))
+}
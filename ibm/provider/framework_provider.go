@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+)
+
+// frameworkProvider serves the features that only terraform-plugin-framework
+// supports, such as ephemeral resources and provider functions, muxed with the SDKv2 provider. It
+// shares the configuration of the SDKv2 provider: its schema is the one of
+// the SDKv2 provider, and its resources use the client session configured
+// by the SDKv2 provider, which is configured first.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+// NewFrameworkProvider returns the terraform-plugin-framework provider muxed
+// with sdkProvider, which must be listed first in the mux server.
+func NewFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+var (
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+)
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "ibm"
+	resp.Version = version.Version
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = frameworkProviderSchema(p.sdkProvider.Schema)
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	meta := p.sdkProvider.Meta()
+	if meta == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The IBM Cloud client session was not configured by the SDKv2 provider")
+		return
+	}
+	resp.DataSourceData = meta
+	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		secretsmanager.NewSmArbitrarySecretEphemeralResource,
+		secretsmanager.NewSmKvSecretEphemeralResource,
+		secretsmanager.NewSmUsernamePasswordSecretEphemeralResource,
+		secretsmanager.NewSmIamCredentialsSecretEphemeralResource,
+		secretsmanager.NewSmImportedCertificateEphemeralResource,
+		secretsmanager.NewSmPrivateCertificateEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		newParseCRNFunction,
+		newBuildCRNFunction,
+		newParseCompositeIDFunction,
+		newResourceGroupFromCRNFunction,
+	}
+}
+
+// frameworkProviderSchema converts the SDKv2 provider schema, since the
+// muxed providers must have identical schemas.
+func frameworkProviderSchema(sdkSchema map[string]*schema.Schema) fwschema.Schema {
+	attributes, blocks := frameworkProviderAttributes(sdkSchema)
+	return fwschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+func frameworkProviderAttributes(sdkSchema map[string]*schema.Schema) (map[string]fwschema.Attribute, map[string]fwschema.Block) {
+	attributes := make(map[string]fwschema.Attribute)
+	blocks := make(map[string]fwschema.Block)
+
+	keys := make([]string, 0, len(sdkSchema))
+	for key := range sdkSchema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := sdkSchema[key]
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			nestedAttributes, nestedBlocks := frameworkProviderAttributes(elem.Schema)
+			nested := fwschema.NestedBlockObject{
+				Attributes: nestedAttributes,
+				Blocks:     nestedBlocks,
+			}
+			if s.Type == schema.TypeSet {
+				blocks[key] = fwschema.SetNestedBlock{NestedObject: nested, Description: s.Description, DeprecationMessage: s.Deprecated}
+			} else {
+				blocks[key] = fwschema.ListNestedBlock{NestedObject: nested, Description: s.Description, DeprecationMessage: s.Deprecated}
+			}
+			continue
+		}
+
+		// The SDKv2 provider makes the required arguments with a default
+		// optional, see schema.Schema.DefaultFunc.
+		required := s.Required && s.DefaultFunc == nil
+		optional := s.Optional || (s.Required && s.DefaultFunc != nil)
+		switch s.Type {
+		case schema.TypeBool:
+			attributes[key] = fwschema.BoolAttribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+		case schema.TypeInt:
+			attributes[key] = fwschema.Int64Attribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+		case schema.TypeFloat:
+			attributes[key] = fwschema.Float64Attribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+		case schema.TypeList:
+			attributes[key] = fwschema.ListAttribute{ElementType: frameworkElementType(s.Elem), Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+		case schema.TypeSet:
+			attributes[key] = fwschema.SetAttribute{ElementType: frameworkElementType(s.Elem), Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+		case schema.TypeMap:
+			attributes[key] = fwschema.MapAttribute{ElementType: frameworkElementType(s.Elem), Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+		default:
+			attributes[key] = fwschema.StringAttribute{Required: required, Optional: optional, Sensitive: s.Sensitive, Description: s.Description, DeprecationMessage: s.Deprecated}
+		}
+	}
+	return attributes, blocks
+}
+
+func frameworkElementType(elem interface{}) attr.Type {
+	s, ok := elem.(*schema.Schema)
+	if !ok {
+		return types.StringType
+	}
+	switch s.Type {
+	case schema.TypeBool:
+		return types.BoolType
+	case schema.TypeInt:
+		return types.Int64Type
+	case schema.TypeFloat:
+		return types.Float64Type
+	default:
+		return types.StringType
+	}
+}
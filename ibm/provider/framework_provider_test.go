@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameworkProviderMux(t *testing.T) {
+	ctx := context.Background()
+	sdkProvider := Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+
+	for _, name := range []string{
+		"ibm_sm_arbitrary_secret",
+		"ibm_sm_kv_secret",
+		"ibm_sm_username_password_secret",
+		"ibm_sm_iam_credentials_secret",
+		"ibm_sm_imported_certificate",
+		"ibm_sm_private_certificate",
+	} {
+		ephemeralSchema, ok := resp.EphemeralResourceSchemas[name]
+		if assert.True(t, ok, "missing ephemeral resource %s", name) {
+			for _, attribute := range ephemeralSchema.Block.Attributes {
+				if attribute.Name == "instance_id" {
+					assert.True(t, attribute.Required)
+				}
+			}
+		}
+		assert.Contains(t, resp.DataSourceSchemas, name)
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -177,6 +178,55 @@ func Provider() *schema.Provider {
 				Description: "The retry count to set for API calls.",
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRIES", 10),
 			},
+			"retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of attempts, including the first one, for API calls made by the IBM Cloud SDK clients. Defaults to max_retries + 1.",
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 100),
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum time (in seconds) to wait between two attempts of an API call. A Retry-After header returned by the service is always honored.",
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 3600),
+			},
+			"retry_on_status_codes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The HTTP status codes of the API calls that are retried. Defaults to 429 and all 5xx status codes except 501.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validate.ValidateAllowedRangeInt(400, 599),
+				},
+			},
+			"diagnostics_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The file that a JSON record of every problem reported by the provider is appended to",
+				DefaultFunc: schema.EnvDefaultFunc("IBMCLOUD_DIAGNOSTICS_FILE", nil),
+			},
+			"recorder_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Records the HTTP interactions of the provider to the recorder cassette, or replays them from it. Allowable values are record and replay.",
+				DefaultFunc:  schema.EnvDefaultFunc("IBMCLOUD_RECORDER_MODE", nil),
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{conns.RecorderModeRecord, conns.RecorderModeReplay}),
+			},
+			"recorder_cassette": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The cassette file used by the HTTP recorder",
+				DefaultFunc: schema.EnvDefaultFunc("IBMCLOUD_RECORDER_CASSETTE", nil),
+			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Client-side rate limits, in requests per second, shared by all the resources and data sources of the provider",
+				Elem: &schema.Resource{
+					Schema: rateLimitsSchema(),
+				},
+			},
 			"function_namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -204,6 +254,70 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"compute_resource_auth": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Authenticate with a trusted profile using the token of the compute resource the provider runs on, either the VPC instance identity (vpc) or a Kubernetes service account (container)",
+				DefaultFunc:  schema.EnvDefaultFunc("IBMCLOUD_COMPUTE_RESOURCE_AUTH", nil),
+				ValidateFunc: validation.StringInSlice(conns.ComputeResourceAuthTypes, false),
+			},
+			"iam_profile_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the trusted profile used by compute resource authentication",
+				DefaultFunc: schema.EnvDefaultFunc("IBMCLOUD_IAM_PROFILE_NAME", nil),
+			},
+			"iam_profile_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CRN of the trusted profile used by VPC instance compute resource authentication",
+				DefaultFunc: schema.EnvDefaultFunc("IBMCLOUD_IAM_PROFILE_CRN", nil),
+			},
+			"cr_token_filename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The file holding the Kubernetes service account token used by container compute resource authentication",
+				DefaultFunc: schema.EnvDefaultFunc("IBMCLOUD_CR_TOKEN_FILENAME", nil),
+			},
+			"metadata_service_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL of the VPC instance metadata service used by VPC instance compute resource authentication",
+				DefaultFunc: schema.EnvDefaultFunc("IBMCLOUD_METADATA_SERVICE_URL", nil),
+			},
+			"assume_trusted_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Assume a trusted profile with the API key, possibly in another account, for every API call of the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The ID of the trusted profile",
+							ExactlyOneOf: []string{"assume_trusted_profile.0.profile_id", "assume_trusted_profile.0.profile_crn", "assume_trusted_profile.0.profile_name"},
+						},
+						"profile_crn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the trusted profile",
+						},
+						"profile_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The name of the trusted profile",
+							RequiredWith: []string{"assume_trusted_profile.0.account_id"},
+						},
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The ID of the account of the trusted profile, required with profile_name",
+							RequiredWith: []string{"assume_trusted_profile.0.profile_name"},
+						},
+					},
+				},
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -229,6 +343,23 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with tags that are attached to every taggable resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "List of tags that are merged into the tags of every taggable resource.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -574,6 +705,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateway":                 vpc.DataSourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateways":                vpc.DataSourceIBMISVPNGateways(),
 			"ibm_is_vpc_address_prefixes":        vpc.DataSourceIbmIsVpcAddressPrefixes(),
+			"ibm_is_vpc_free_cidrs":              vpc.DataSourceIBMIsVPCFreeCIDRs(),
 			"ibm_is_vpc_address_prefix":          vpc.DataSourceIBMIsVPCAddressPrefix(),
 			"ibm_is_vpn_gateway_connection":      vpc.DataSourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpn_gateway_connections":     vpc.DataSourceIBMISVPNGatewayConnections(),
@@ -601,6 +733,7 @@ func Provider() *schema.Provider {
 			"ibm_is_network_acl":                     vpc.DataSourceIBMIsNetworkACL(),
 			"ibm_is_network_acl_rule":                vpc.DataSourceIBMISNetworkACLRule(),
 			"ibm_is_network_acl_rules":               vpc.DataSourceIBMISNetworkACLRules(),
+			"ibm_is_network_path_analysis":           vpc.DataSourceIBMIsNetworkPathAnalysis(),
 			"ibm_lbaas":                              classicinfrastructure.DataSourceIBMLbaas(),
 			"ibm_network_vlan":                       classicinfrastructure.DataSourceIBMNetworkVlan(),
 			"ibm_org":                                cloudfoundry.DataSourceIBMOrg(),
@@ -1139,9 +1272,13 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket":                               cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":              cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                        cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_objects_sync":                  cos.ResourceIBMCOSBucketObjectsSync(),
 			"ibm_cos_bucket_object_lock_configuration":     cos.ResourceIBMCOSBucketObjectlock(),
 			"ibm_cos_bucket_website_configuration":         cos.ResourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_cos_bucket_lifecycle_configuration":       cos.ResourceIBMCOSBucketLifecycleConfiguration(),
+			"ibm_cos_bucket_cors_configuration":            cos.ResourceIBMCOSBucketCorsConfiguration(),
+			"ibm_cos_bucket_public_access_block":           cos.ResourceIBMCOSBucketPublicAccessBlock(),
+			"ibm_cos_bucket_policy":                        cos.ResourceIBMCOSBucketPolicy(),
 			"ibm_dns_domain":                               classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":      classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                            classicinfrastructure.ResourceIBMDNSSecondary(),
@@ -1274,6 +1411,9 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateway_connection":                vpc.ResourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpc":                                   vpc.ResourceIBMISVPC(),
 			"ibm_is_vpc_address_prefix":                    vpc.ResourceIBMISVpcAddressPrefix(),
+			"ibm_is_vpc_default_network_acl":               vpc.ResourceIBMISVPCDefaultNetworkACL(),
+			"ibm_is_vpc_default_routing_table":             vpc.ResourceIBMISVPCDefaultRoutingTable(),
+			"ibm_is_vpc_default_security_group":            vpc.ResourceIBMISVPCDefaultSecurityGroup(),
 			"ibm_is_vpc_dns_resolution_binding":            vpc.ResourceIBMIsVPCDnsResolutionBinding(),
 			"ibm_is_vpc_routing_table":                     vpc.ResourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":               vpc.ResourceIBMISVPCRoutingTableRoute(),
@@ -1633,7 +1773,7 @@ func Provider() *schema.Provider {
 			"ibm_logs_router_tenant": logsrouting.ResourceIBMLogsRouterTenant(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
 
 	wrappedProvider := wrapProvider(provider)
@@ -1653,16 +1793,117 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 	}
 
 	return schema.Provider{
-		Schema:         provider.Schema,
-		DataSourcesMap: wrappedDataSourcesMap,
-		ResourcesMap:   wrappedResourcesMap,
-		ConfigureFunc:  provider.ConfigureFunc,
+		Schema:               provider.Schema,
+		DataSourcesMap:       wrappedDataSourcesMap,
+		ResourcesMap:         wrappedResourcesMap,
+		ConfigureContextFunc: provider.ConfigureContextFunc,
 	}
 }
 
+// deletionProtectedResources lists the stateful resources that get an optional
+// deletion_protection attribute injected by the provider wrapper, so that a
+// stray destroy cannot wipe production data.
+var deletionProtectedResources = map[string]bool{
+	"ibm_container_vpc_cluster":         true,
+	"ibm_cos_bucket":                    true,
+	"ibm_is_snapshot":                   true,
+	"ibm_is_volume":                     true,
+	"ibm_kms_key":                       true,
+	"ibm_pi_instance":                   true,
+	"ibm_pi_volume":                     true,
+	"ibm_resource_instance":             true,
+	"ibm_sm_arbitrary_secret":           true,
+	"ibm_sm_iam_credentials_secret":     true,
+	"ibm_sm_imported_certificate":       true,
+	"ibm_sm_kv_secret":                  true,
+	"ibm_sm_private_certificate":        true,
+	"ibm_sm_public_certificate":         true,
+	"ibm_sm_service_credentials_secret": true,
+	"ibm_sm_username_password_secret":   true,
+}
+
+// importerExemptResources lists the resources that cannot be imported, with
+// the reason. Every other resource declares an Importer, see
+// TestProviderImporters.
+var importerExemptResources = map[string]string{
+	"ibm_container_api_key_reset":         "an action, which resets the API key on create",
+	"ibm_cos_bucket_objects_sync":         "its files are read from a local directory, which is not part of the bucket",
+	"ibm_iam_authorization_policy_detach": "an action, which detaches an authorization policy on create",
+	"ibm_scc_account_settings":            "deprecated, every operation fails",
+	"ibm_scc_rule_attachment":             "deprecated, every operation fails",
+	"ibm_scc_template":                    "deprecated, every operation fails",
+	"ibm_scc_template_attachment":         "deprecated, every operation fails",
+}
+
+// taggableResources lists the resources whose tags attribute holds user tags
+// attached through Global Tagging. Provider level default tags are only merged
+// into these resources; other resources use a tags attribute for unrelated
+// values, such as the tags managed by ibm_resource_tag or IAM policies.
+var taggableResources = map[string]bool{
+	"ibm_cd_toolchain":                  true,
+	"ibm_cis":                           true,
+	"ibm_container_cluster":             true,
+	"ibm_container_vpc_cluster":         true,
+	"ibm_database":                      true,
+	"ibm_db2":                           true,
+	"ibm_dl_gateway":                    true,
+	"ibm_dl_gateway_action":             true,
+	"ibm_dl_provider_gateway":           true,
+	"ibm_hpcs":                          true,
+	"ibm_is_bare_metal_server":          true,
+	"ibm_is_floating_ip":                true,
+	"ibm_is_flow_log":                   true,
+	"ibm_is_image":                      true,
+	"ibm_is_instance":                   true,
+	"ibm_is_instance_group":             true,
+	"ibm_is_instance_volume_attachment": true,
+	"ibm_is_lb":                         true,
+	"ibm_is_network_acl":                true,
+	"ibm_is_placement_group":            true,
+	"ibm_is_public_gateway":             true,
+	"ibm_is_security_group":             true,
+	"ibm_is_share":                      true,
+	"ibm_is_snapshot":                   true,
+	"ibm_is_snapshot_consistency_group": true,
+	"ibm_is_ssh_key":                    true,
+	"ibm_is_subnet":                     true,
+	"ibm_is_virtual_endpoint_gateway":   true,
+	"ibm_is_virtual_network_interface":  true,
+	"ibm_is_volume":                     true,
+	"ibm_is_vpc":                        true,
+	"ibm_is_vpc_routing_table":          true,
+	"ibm_is_vpn_gateway":                true,
+	"ibm_pi_capture":                    true,
+	"ibm_pi_image":                      true,
+	"ibm_pi_instance":                   true,
+	"ibm_pi_network":                    true,
+	"ibm_pi_network_address_group":      true,
+	"ibm_pi_network_interface":          true,
+	"ibm_pi_network_security_group":     true,
+	"ibm_pi_shared_processor_pool":      true,
+	"ibm_pi_snapshot":                   true,
+	"ibm_pi_volume":                     true,
+	"ibm_pi_workspace":                  true,
+	"ibm_resource_instance":             true,
+	"ibm_satellite_cluster":             true,
+	"ibm_satellite_location":            true,
+	"ibm_tg_gateway":                    true,
+}
+
+// taggableAttributes lists the attributes that hold the user tags of a
+// resource. Provider level default tags are merged into these attributes.
+var taggableAttributes = []string{"tags", "pi_user_tags"}
+
 func wrapResource(name string, resource *schema.Resource) *schema.Resource {
+	resourceSchema, tagKey, optionalComputed := resource.Schema, "", false
+	if taggableResources[name] {
+		resourceSchema, tagKey, optionalComputed = wrapTagsSchema(resourceSchema)
+	}
+	if deletionProtectedResources[name] {
+		resourceSchema = wrapDeletionProtectionSchema(resourceSchema)
+	}
 	return &schema.Resource{
-		Schema:               resource.Schema,
+		Schema:               resourceSchema,
 		SchemaVersion:        resource.SchemaVersion,
 		MigrateState:         resource.MigrateState,
 		StateUpgraders:       resource.StateUpgraders,
@@ -1675,7 +1916,7 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 		ReadWithoutTimeout:   wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, false),
 		UpdateWithoutTimeout: wrapFunction(name, "update", resource.UpdateWithoutTimeout, nil, false),
 		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
-		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
+		CustomizeDiff:        wrapCustomizeDiff(name, wrapDefaultTagsCustomizeDiff(tagKey, optionalComputed, resource.CustomizeDiff)),
 		Importer:             resource.Importer,
 		DeprecationMessage:   resource.DeprecationMessage,
 		Timeouts:             resource.Timeouts,
@@ -1684,6 +1925,71 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 	}
 }
 
+// wrapDeletionProtectionSchema returns a copy of the resource schema with an
+// optional deletion_protection attribute, which is enforced by wrapFunction.
+func wrapDeletionProtectionSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	if _, ok := resourceSchema[flex.DeletionProtection]; ok {
+		return resourceSchema
+	}
+
+	wrappedSchema := make(map[string]*schema.Schema, len(resourceSchema)+1)
+	for k, v := range resourceSchema {
+		wrappedSchema[k] = v
+	}
+	wrappedSchema[flex.DeletionProtection] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether Terraform will be prevented from destroying the resource",
+	}
+	return wrappedSchema
+}
+
+// wrapTagsSchema returns a copy of the resource schema in which the user tags
+// attribute is marked as Computed, so that the provider level default tags can
+// be merged into its planned value. It also returns the name of the tags
+// attribute and whether it was already declared as Optional+Computed.
+func wrapTagsSchema(resourceSchema map[string]*schema.Schema) (map[string]*schema.Schema, string, bool) {
+	for _, key := range taggableAttributes {
+		tagSchema, ok := resourceSchema[key]
+		if !ok || tagSchema.Type != schema.TypeSet || !tagSchema.Optional || tagSchema.ForceNew {
+			continue
+		}
+		if elem, ok := tagSchema.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+			continue
+		}
+
+		wrappedSchema := make(map[string]*schema.Schema, len(resourceSchema))
+		for k, v := range resourceSchema {
+			wrappedSchema[k] = v
+		}
+		wrappedTagSchema := *tagSchema
+		wrappedTagSchema.Computed = true
+		wrappedSchema[key] = &wrappedTagSchema
+		return wrappedSchema, key, tagSchema.Computed
+	}
+	return resourceSchema, "", false
+}
+
+func wrapDefaultTagsCustomizeDiff(tagKey string, optionalComputed bool, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if tagKey == "" {
+		return function
+	}
+
+	return func(c context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+		if function != nil {
+			if err := function(c, rd, meta); err != nil {
+				return err
+			}
+		}
+		var defaultTags []string
+		if session, ok := meta.(conns.ClientSession); ok {
+			defaultTags = session.DefaultTags()
+		}
+		return flex.ResourceDefaultTagsCustomizeDiff(rd, tagKey, defaultTags, optionalComputed)
+	}
+}
+
 func wrapDataSource(name string, resource *schema.Resource) *schema.Resource {
 	return &schema.Resource{
 		Schema:             resource.Schema,
@@ -1707,37 +2013,49 @@ func wrapFunction(
 	fallback func(*schema.ResourceData, interface{}) error,
 	isDataSource bool,
 ) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
-	if function != nil {
-		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-
-			// only allow deletion if the resource is not marked as protected
-			if operationName == "delete" && schema.Get("deletion_protection") != nil {
-				// we check the value in state, not current config. Current config will always be null for a delete
-
-				if schema.Get("deletion_protection") == true {
-					log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
-					var diags diag.Diagnostics
-					summary := fmt.Sprintf("Deletion protection is enabled for resource %s to prevent accidential deletion", schema.Get("name"))
-					return append(
-						diags,
-						diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  summary,
-							Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
-						},
-					)
-				}
-			}
-
-			return function(context, schema, meta)
-		}
-	} else if fallback != nil {
-		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if function == nil && fallback != nil {
+		function = func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
 			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
 		}
 	}
+	if function == nil {
+		return nil
+	}
+
+	return func(context context.Context, schema *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 
-	return nil
+		// warnings reported by the resource code are returned along with its
+		// result, and forgotten whatever the outcome of the operation
+		flex.CollectWarnings(schema)
+		defer func() {
+			diags = append(diags, flex.PopWarnings(schema)...)
+		}()
+
+		// only allow deletion if the resource is not marked as protected
+		if operationName == "delete" && schema.Get(flex.DeletionProtection) != nil {
+			// we check the value in state, not current config. Current config will always be null for a delete
+
+			if schema.Get(flex.DeletionProtection) == true {
+				log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
+				name := schema.Id()
+				if v, ok := schema.GetOk("name"); ok {
+					name = fmt.Sprint(v)
+				}
+				var diags diag.Diagnostics
+				summary := fmt.Sprintf("Deletion protection is enabled for resource %s to prevent accidential deletion", name)
+				return append(
+					diags,
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  summary,
+						Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
+					},
+				)
+			}
+		}
+
+		return function(context, schema, meta)
+	}
 }
 
 func wrapError(err error, resourceName, operationName string, isDataSource bool) diag.Diagnostics {
@@ -1762,12 +2080,13 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 	}
 
 	log.Printf("[DEBUG] %s", tfError.GetDebugMessage())
+	flex.WriteDiagnostic(tfError)
 	return append(
 		diags,
 		diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  tfError.Error(),
-			Detail:   tfError.GetConsoleMessage(),
+			Detail:   tfError.GetConsoleDetail(),
 		},
 	)
 }
@@ -1794,7 +2113,8 @@ func wrapDiffErrors(err error, resourceName string) error {
 		// Although it would be ideal to return the full TerraformError object, it is sufficient
 		// to package the console message into a new error so that the user gets the information.
 		log.Printf("[DEBUG] %s", tfError.GetDebugMessage())
-		return errors.New(tfError.GetConsoleMessage())
+		flex.WriteDiagnostic(tfError)
+		return errors.New(tfError.GetConsoleDetail())
 	}
 
 	// Return the nil error.
@@ -2217,7 +2537,7 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId string
@@ -2277,17 +2597,66 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	var diags diag.Diagnostics
+	var endpoints conns.EndpointsFile
+	if f := conns.EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, file); f != "" {
+		endpointsFile, warnings, err := conns.LoadEndpointsFile(f)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		for _, warning := range warnings {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  warning,
+				Detail:   "The supported service keys, visibilities and regions are listed in the custom service endpoints guide.",
+			})
+		}
+		endpoints = endpointsFile
+	}
+
+	var defaultTags []string
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagsBlock := v.([]interface{})[0].(map[string]interface{})
+		if tags, ok := tagsBlock["tags"]; ok {
+			defaultTags = flex.ExpandStringList(tags.(*schema.Set).List())
+		}
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	retryMaxAttempts := d.Get("retry_max_attempts").(int)
+	retryMaxBackoff := d.Get("retry_max_backoff").(int)
+	var retryOnStatusCodes []int
+	for _, code := range d.Get("retry_on_status_codes").(*schema.Set).List() {
+		retryOnStatusCodes = append(retryOnStatusCodes, code.(int))
+	}
+	flex.SetDiagnosticsFile(d.Get("diagnostics_file").(string))
+	recorderMode := d.Get("recorder_mode").(string)
+	recorderCassette := d.Get("recorder_cassette").(string)
+	rateLimits := make(map[string]float64)
+	if v, ok := d.GetOk("rate_limits"); ok && v.([]interface{})[0] != nil {
+		for service, limit := range v.([]interface{})[0].(map[string]interface{}) {
+			rateLimits[service] = limit.(float64)
+		}
+	}
+	var assumeTrustedProfile *conns.AssumeTrustedProfile
+	if v, ok := d.GetOk("assume_trusted_profile"); ok && v.([]interface{})[0] != nil {
+		profile := v.([]interface{})[0].(map[string]interface{})
+		assumeTrustedProfile = &conns.AssumeTrustedProfile{
+			ProfileID:   profile["profile_id"].(string),
+			ProfileCRN:  profile["profile_crn"].(string),
+			ProfileName: profile["profile_name"].(string),
+			AccountID:   profile["account_id"].(string),
+		}
+	}
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
-		return nil, err
+		return nil, append(diags, diag.FromErr(err)...)
 	}
 	// Set environment variable to be used in DiffSupressFunction
 	if wskEnvVal.(string) == "" {
@@ -2305,6 +2674,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		RetryCount:           retryCount,
 		SoftLayerEndpointURL: softlayerEndpointUrl,
 		RetryDelay:           conns.RetryAPIDelay,
+		RetryMaxAttempts:     retryMaxAttempts,
+		RetryMaxBackoff:      time.Duration(retryMaxBackoff) * time.Second,
+		RetryOnStatusCodes:   retryOnStatusCodes,
+		RateLimits:           rateLimits,
+		RecorderMode:         recorderMode,
+		RecorderCassette:     recorderCassette,
 		FunctionNameSpace:    wskNameSpace,
 		RiaasEndPoint:        riaasEndPoint,
 		IAMToken:             iamToken,
@@ -2312,8 +2687,36 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Zone:                 zone,
 		Visibility:           visibility,
 		EndpointsFile:        file,
+		Endpoints:            endpoints,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		ComputeResourceAuth:  d.Get("compute_resource_auth").(string),
+		IAMProfileName:       d.Get("iam_profile_name").(string),
+		IAMProfileCRN:        d.Get("iam_profile_crn").(string),
+		CRTokenFilename:      d.Get("cr_token_filename").(string),
+		MetadataServiceURL:   d.Get("metadata_service_url").(string),
+		AssumeTrustedProfile: assumeTrustedProfile,
+		DefaultTags:          defaultTags,
+	}
+	if stopContext, ok := schema.StopContext(ctx); ok {
+		config.StopContext = stopContext
 	}
 
-	return config.ClientSession()
+	session, err := config.ClientSession()
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return session, diags
+}
+
+func rateLimitsSchema() map[string]*schema.Schema {
+	rateLimits := make(map[string]*schema.Schema, len(conns.RateLimitServices))
+	for _, service := range conns.RateLimitServices {
+		rateLimits[service] = &schema.Schema{
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  fmt.Sprintf("The maximum number of requests per second sent to the %s APIs", service),
+			ValidateFunc: validation.FloatAtLeast(0.01),
+		}
+	}
+	return rateLimits
 }
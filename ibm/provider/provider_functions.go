@@ -0,0 +1,301 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// The attributes of the object returned by parse_crn, and accepted by
+// build_crn
+var crnAttributeTypes = map[string]attr.Type{
+	"version":          types.StringType,
+	"cname":            types.StringType,
+	"ctype":            types.StringType,
+	"service_name":     types.StringType,
+	"region":           types.StringType,
+	"scope_type":       types.StringType,
+	"scope":            types.StringType,
+	"account_id":       types.StringType,
+	"service_instance": types.StringType,
+	"resource_type":    types.StringType,
+	"resource":         types.StringType,
+}
+
+const crnDescription = "A CRN has the form `crn:version:cname:ctype:service_name:region:scope_type/scope:service_instance:resource_type:resource`. " +
+	"The `account_id` is the scope of the CRNs scoped to an account, which have the `a` scope type."
+
+func newParseCRNFunction() function.Function {
+	return &parseCRNFunction{}
+}
+
+// parseCRNFunction implements provider::ibm::parse_crn.
+type parseCRNFunction struct{}
+
+func (f *parseCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_crn"
+}
+
+func (f *parseCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a Cloud Resource Name (CRN)",
+		Description: "Returns the segments of a CRN as an object. " + crnDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to parse.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: crnAttributeTypes},
+	}
+}
+
+func (f *parseCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var crn string
+	resp.Error = req.Arguments.Get(ctx, &crn)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, funcErr := parseCRNArgument(crn, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	accountID := ""
+	if parsed.ScopeType == "a" {
+		accountID = parsed.Scope
+	}
+	result, diags := types.ObjectValue(crnAttributeTypes, map[string]attr.Value{
+		"version":          types.StringValue(parsed.Version),
+		"cname":            types.StringValue(parsed.CName),
+		"ctype":            types.StringValue(parsed.CType),
+		"service_name":     types.StringValue(parsed.ServiceName),
+		"region":           types.StringValue(parsed.Region),
+		"scope_type":       types.StringValue(parsed.ScopeType),
+		"scope":            types.StringValue(parsed.Scope),
+		"account_id":       types.StringValue(accountID),
+		"service_instance": types.StringValue(parsed.ServiceInstance),
+		"resource_type":    types.StringValue(parsed.ResourceType),
+		"resource":         types.StringValue(parsed.Resource),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+func newBuildCRNFunction() function.Function {
+	return &buildCRNFunction{}
+}
+
+// buildCRNFunction implements provider::ibm::build_crn.
+type buildCRNFunction struct{}
+
+func (f *buildCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_crn"
+}
+
+func (f *buildCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a Cloud Resource Name (CRN)",
+		Description: "Returns the CRN of the given segments, which have the names of the attributes returned by parse_crn. " + crnDescription + " " +
+			"The `version`, `cname` and `ctype` segments default to `v1`, `bluemix` and `public`, and the other ones to an empty segment.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "segments",
+				Description: "The segments of the CRN.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var segments map[string]string
+	resp.Error = req.Arguments.Get(ctx, &segments)
+	if resp.Error != nil {
+		return
+	}
+
+	for _, name := range sortedStringKeys(segments) {
+		if _, ok := crnAttributeTypes[name]; !ok {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unknown CRN segment %q", name))
+			return
+		}
+	}
+	crn := flex.CRN{
+		Scheme:          "crn",
+		Version:         "v1",
+		CName:           "bluemix",
+		CType:           "public",
+		ServiceName:     segments["service_name"],
+		Region:          segments["region"],
+		ScopeType:       segments["scope_type"],
+		Scope:           segments["scope"],
+		ServiceInstance: segments["service_instance"],
+		ResourceType:    segments["resource_type"],
+		Resource:        segments["resource"],
+	}
+	if v := segments["version"]; v != "" {
+		crn.Version = v
+	}
+	if v := segments["cname"]; v != "" {
+		crn.CName = v
+	}
+	if v := segments["ctype"]; v != "" {
+		crn.CType = v
+	}
+	if accountID := segments["account_id"]; accountID != "" {
+		if (crn.ScopeType != "" && crn.ScopeType != "a") || (crn.Scope != "" && crn.Scope != accountID) {
+			resp.Error = function.NewArgumentFuncError(0, "account_id cannot be combined with another scope")
+			return
+		}
+		crn.ScopeType, crn.Scope = "a", accountID
+	}
+
+	// The result must be a valid CRN
+	if _, err := flex.Parse(crn.String()); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid CRN segments: %s", err))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, crn.String())
+}
+
+func newParseCompositeIDFunction() function.Function {
+	return &parseCompositeIDFunction{}
+}
+
+// parseCompositeIDFunction implements provider::ibm::parse_composite_id.
+type parseCompositeIDFunction struct{}
+
+func (f *parseCompositeIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_composite_id"
+}
+
+func (f *parseCompositeIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse the composite ID of a resource",
+		Description: "Returns the parts of the composite ID of a resource, such as `<instance_id>/<key_id>`, in the order documented by the import section of the resource. " +
+			"The parts are separated by `/`, unless another separator is given.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The composite ID to parse.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "separator",
+			Description: "The non-empty separator of the parts, `/` by default.",
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *parseCompositeIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	var separators []string
+	resp.Error = req.Arguments.Get(ctx, &id, &separators)
+	if resp.Error != nil {
+		return
+	}
+	if len(separators) > 1 {
+		resp.Error = function.NewArgumentFuncError(1, "At most one separator can be given")
+		return
+	}
+	if len(separators) == 1 && separators[0] == "" {
+		resp.Error = function.NewArgumentFuncError(1, "The separator must not be empty")
+		return
+	}
+
+	var parts []string
+	var err error
+	if len(separators) == 0 {
+		parts, err = flex.IdParts(id)
+	} else {
+		parts, err = flex.SepIdParts(id, separators[0])
+	}
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, parts)
+}
+
+func newResourceGroupFromCRNFunction() function.Function {
+	return &resourceGroupFromCRNFunction{}
+}
+
+// resourceGroupFromCRNFunction implements
+// provider::ibm::resource_group_from_crn.
+type resourceGroupFromCRNFunction struct{}
+
+func (f *resourceGroupFromCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "resource_group_from_crn"
+}
+
+func (f *resourceGroupFromCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Get the resource group ID of a resource group CRN",
+		Description: "Returns the ID of the resource group identified by a CRN, of the form `crn:v1:bluemix:public:resource-controller::a/<account_id>::resource-group:<resource_group_id>`. " +
+			"The CRN of a resource does not hold its resource group, which is only available from the API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN of the resource group.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *resourceGroupFromCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var crn string
+	resp.Error = req.Arguments.Get(ctx, &crn)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, funcErr := parseCRNArgument(crn, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	if parsed.ResourceType != "resource-group" || parsed.Resource == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The CRN %s does not identify a resource group", crn))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, parsed.Resource)
+}
+
+// parseCRNArgument parses the CRN given as the argument at position.
+func parseCRNArgument(crn string, position int64) (flex.CRN, *function.FuncError) {
+	if crn == "" {
+		return flex.CRN{}, function.NewArgumentFuncError(position, "The CRN cannot be empty")
+	}
+	parsed, err := flex.Parse(crn)
+	if err != nil {
+		return flex.CRN{}, function.NewArgumentFuncError(position, fmt.Sprintf("Invalid CRN %s: %s", crn, err))
+	}
+	return parsed, nil
+}
+
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	ctx := context.Background()
+	var definition function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+	resp := function.RunResponse{
+		Result: function.NewResultData(definition.Definition.Return.GetType().ValueType(ctx)),
+	}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func stringTuple(values ...string) attr.Value {
+	elementTypes := make([]attr.Type, 0, len(values))
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elementTypes = append(elementTypes, types.StringType)
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.TupleValueMust(elementTypes, elements)
+}
+
+const testInstanceCRN = "crn:v1:bluemix:public:secrets-manager:us-south:a/0123456789abcdef:7a1b3c5d-0000-4000-8000-000000000001:secret:0b5571f7-21e6-42b7-91c5-3f5ac9793a46"
+
+func TestParseCRNFunction(t *testing.T) {
+	result, err := runFunction(t, newParseCRNFunction(), types.StringValue(testInstanceCRN))
+	if !assert.Nil(t, err) {
+		return
+	}
+	attributes := result.(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("secrets-manager"), attributes["service_name"])
+	assert.Equal(t, types.StringValue("us-south"), attributes["region"])
+	assert.Equal(t, types.StringValue("a"), attributes["scope_type"])
+	assert.Equal(t, types.StringValue("0123456789abcdef"), attributes["account_id"])
+	assert.Equal(t, types.StringValue("7a1b3c5d-0000-4000-8000-000000000001"), attributes["service_instance"])
+	assert.Equal(t, types.StringValue("secret"), attributes["resource_type"])
+	assert.Equal(t, types.StringValue("0b5571f7-21e6-42b7-91c5-3f5ac9793a46"), attributes["resource"])
+
+	for _, crn := range []string{"", "crn:v1:bluemix", "crn:v1:bluemix:public:is:us-south:a/b/c:::"} {
+		_, err := runFunction(t, newParseCRNFunction(), types.StringValue(crn))
+		assert.NotNil(t, err, crn)
+	}
+}
+
+func TestBuildCRNFunction(t *testing.T) {
+	segments := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"service_name":     types.StringValue("secrets-manager"),
+		"region":           types.StringValue("us-south"),
+		"account_id":       types.StringValue("0123456789abcdef"),
+		"service_instance": types.StringValue("7a1b3c5d-0000-4000-8000-000000000001"),
+		"resource_type":    types.StringValue("secret"),
+		"resource":         types.StringValue("0b5571f7-21e6-42b7-91c5-3f5ac9793a46"),
+	})
+	result, err := runFunction(t, newBuildCRNFunction(), segments)
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue(testInstanceCRN), result)
+
+	// The object of parse_crn builds the CRN back
+	parsed, _ := runFunction(t, newParseCRNFunction(), types.StringValue(testInstanceCRN))
+	roundTrip := make(map[string]attr.Value)
+	for k, v := range parsed.(types.Object).Attributes() {
+		roundTrip[k] = v
+	}
+	result, err = runFunction(t, newBuildCRNFunction(), types.MapValueMust(types.StringType, roundTrip))
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue(testInstanceCRN), result)
+
+	for name, segments := range map[string]map[string]attr.Value{
+		"unknown segment": {"service": types.StringValue("is")},
+		"account and scope": {
+			"account_id": types.StringValue("0123456789abcdef"),
+			"scope_type": types.StringValue("o"),
+			"scope":      types.StringValue("org"),
+		},
+		"invalid segment": {"region": types.StringValue("us:south")},
+	} {
+		_, err := runFunction(t, newBuildCRNFunction(), types.MapValueMust(types.StringType, segments))
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestParseCompositeIDFunction(t *testing.T) {
+	result, err := runFunction(t, newParseCompositeIDFunction(), types.StringValue("us-south/instance/key"), stringTuple())
+	assert.Nil(t, err)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("us-south"), types.StringValue("instance"), types.StringValue("key"),
+	}), result)
+
+	result, err = runFunction(t, newParseCompositeIDFunction(), types.StringValue("vpc:rule"), stringTuple(":"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("vpc"), types.StringValue("rule"),
+	}), result)
+
+	_, err = runFunction(t, newParseCompositeIDFunction(), types.StringValue("instance"), stringTuple())
+	assert.NotNil(t, err)
+	_, err = runFunction(t, newParseCompositeIDFunction(), types.StringValue("a/b"), stringTuple("/", ":"))
+	assert.NotNil(t, err)
+	_, err = runFunction(t, newParseCompositeIDFunction(), types.StringValue("a/b"), stringTuple(""))
+	assert.NotNil(t, err)
+}
+
+func TestResourceGroupFromCRNFunction(t *testing.T) {
+	result, err := runFunction(t, newResourceGroupFromCRNFunction(), types.StringValue("crn:v1:bluemix:public:resource-controller::a/0123456789abcdef::resource-group:4f6c0cd1e5b04c6e8d9a5e5e6f7a8b9c"))
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue("4f6c0cd1e5b04c6e8d9a5e5e6f7a8b9c"), result)
+
+	_, err = runFunction(t, newResourceGroupFromCRNFunction(), types.StringValue(testInstanceCRN))
+	assert.NotNil(t, err)
+}
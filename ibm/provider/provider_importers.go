@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/importer"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cis"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamaccessgroup"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+)
+
+// ImportListers returns the listers of the resource types whose existing
+// objects can be enumerated by the generate-imports command.
+func ImportListers() map[string]importer.Lister {
+	listers := make(map[string]importer.Lister)
+	for _, serviceListers := range []map[string]importer.Lister{
+		vpc.ImportListers(),
+		cis.ImportListers(),
+		iamaccessgroup.ImportListers(),
+		resourcecontroller.ImportListers(),
+	} {
+		for resourceType, lister := range serviceListers {
+			listers[resourceType] = lister
+		}
+	}
+	return listers
+}
+
+// GenerateImports implements the generate-imports command, which writes to
+// w the import blocks of the existing objects of the given resource types.
+// The provider is configured from the environment, like in a configuration
+// without a provider block, for example with IC_API_KEY and IC_REGION.
+func GenerateImports(ctx context.Context, args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("generate-imports", flag.ContinueOnError)
+	var resourceTypes, options stringListFlag
+	flags.Var(&resourceTypes, "type", "A resource type to list, such as ibm_is_subnet. Can be repeated, all the supported types are listed by default.")
+	flags.Var(&options, "option", "A key=value filter of the listed objects: resource_group_id, cis_id or domain_id. Can be repeated.")
+	listTypes := flags.Bool("list-types", false, "Print the supported resource types and exit.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	listers := ImportListers()
+	if *listTypes {
+		_, err := fmt.Fprintln(w, strings.Join(importer.ResourceTypes(listers), "\n"))
+		return err
+	}
+	optionValues := make(map[string]string)
+	for _, option := range options {
+		parts := strings.SplitN(option, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("[ERROR] The option %q must have the form key=value", option)
+		}
+		optionValues[parts[0]] = parts[1]
+	}
+
+	sdkProvider := Provider()
+	diags := sdkProvider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	if diags.HasError() {
+		return fmt.Errorf("[ERROR] Error configuring the provider: %v", diags)
+	}
+	return importer.Generate(w, sdkProvider.Meta(), listers, resourceTypes, optionValues)
+}
+
+// stringListFlag is a flag which can be repeated, or given a comma separated
+// list of values.
+type stringListFlag []string
+
+func (f *stringListFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringListFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f = append(*f, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportListers(t *testing.T) {
+	provider := Provider()
+	for resourceType := range ImportListers() {
+		resource, ok := provider.ResourcesMap[resourceType]
+		if !assert.True(t, ok, "%s is not registered in the provider", resourceType) {
+			continue
+		}
+		assert.NotNil(t, resource.Importer, "%s cannot be imported", resourceType)
+	}
+}
+
+func TestGenerateImports(t *testing.T) {
+	var buf bytes.Buffer
+	err := GenerateImports(context.Background(), []string{"-list-types"}, &buf)
+	assert.NoError(t, err)
+	resourceTypes := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, resourceTypes, len(ImportListers()))
+	assert.Contains(t, resourceTypes, "ibm_is_subnet")
+	assert.Contains(t, resourceTypes, "ibm_cis_dns_record")
+
+	err = GenerateImports(context.Background(), []string{"-option", "cis_id"}, &buf)
+	assert.EqualError(t, err, `[ERROR] The option "cis_id" must have the form key=value`)
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestProviderDeletionProtection(t *testing.T) {
+	provider := Provider()
+	for name := range deletionProtectedResources {
+		resource, ok := provider.ResourcesMap[name]
+		if !assert.True(t, ok, "%s is not registered in the provider", name) {
+			continue
+		}
+
+		protection, ok := resource.Schema[flex.DeletionProtection]
+		if assert.True(t, ok, "%s does not declare %s", name, flex.DeletionProtection) {
+			assert.Equal(t, schema.TypeBool, protection.Type)
+			assert.True(t, protection.Optional)
+		}
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			flex.DeletionProtection: true,
+		})
+		d.SetId("protected")
+		diags := resource.DeleteContext(context.Background(), d, nil)
+		if assert.Len(t, diags, 1, "%s was not protected from deletion", name) {
+			assert.Equal(t, diag.Error, diags[0].Severity)
+		}
+	}
+}
+
+func TestProviderDefaultTags(t *testing.T) {
+	provider := Provider()
+	for name := range taggableResources {
+		resource, ok := provider.ResourcesMap[name]
+		if !assert.True(t, ok, "%s is not registered in the provider", name) {
+			continue
+		}
+		tagged := false
+		for _, key := range taggableAttributes {
+			if tagSchema, ok := resource.Schema[key]; ok && tagSchema.Optional && tagSchema.Computed {
+				tagged = true
+			}
+		}
+		assert.True(t, tagged, "%s has no user tags attribute default tags are merged into", name)
+	}
+
+	// the tags of IAM policies are not user tags
+	tagSchema := provider.ResourcesMap["ibm_iam_user_policy"].Schema["tags"]
+	assert.False(t, tagSchema.Computed)
+}
+
+func TestProviderImporters(t *testing.T) {
+	provider := Provider()
+	for name, resource := range provider.ResourcesMap {
+		if _, ok := importerExemptResources[name]; ok {
+			assert.Nil(t, resource.Importer, "%s has an importer but is exempted from one", name)
+			continue
+		}
+		assert.NotNil(t, resource.Importer, "%s has no importer, add one or exempt it in importerExemptResources", name)
+	}
+	for name := range importerExemptResources {
+		assert.Contains(t, provider.ResourcesMap, name, "%s is not registered in the provider", name)
+	}
+}
+
+func TestWrapFunctionWarnings(t *testing.T) {
+	function := wrapFunction("ibm_some_resource", "create", nil, func(d *schema.ResourceData, meta interface{}) error {
+		flex.AddWarningf(d, "Error on create of resource (%s) tags: %s", d.Id(), "rate limited")
+		d.SetId("some-id")
+		return nil
+	}, false)
+
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}}
+	d := resource.TestResourceData()
+	diags := function(context.Background(), d, nil)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "Error on create of resource () tags: rate limited", diags[0].Summary)
+	}
+	assert.False(t, diags.HasError())
+	assert.Empty(t, flex.PopWarnings(d))
+}
+
+func TestWrapFunctionWarningsOnFailure(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}}
+	d := resource.TestResourceData()
+	function := wrapFunction("ibm_some_resource", "update", func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		flex.AddWarning(d, "Error on update of resource tags", "")
+		panic("update failed")
+	}, nil, false)
+
+	assert.Panics(t, func() { function(context.Background(), d, nil) })
+	assert.Empty(t, flex.PopWarnings(d))
+}
+
+func TestProviderConfigureEndpointsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(path, []byte(`{"IBMCLOUD_IS_NG_API_ENDPOINT": "https://vpc.example.com/v1"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{"endpoints_file_path": path})
+	_, diags := providerConfigure(context.Background(), d)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Summary, "must be an object of visibilities")
+	}
+}
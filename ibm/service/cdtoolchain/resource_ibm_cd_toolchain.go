@@ -185,7 +185,7 @@ func resourceIBMCdToolchainCreate(context context.Context, d *schema.ResourceDat
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *toolchainPost.CRN)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of toolchain (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -317,7 +317,7 @@ func resourceIBMCdToolchainUpdate(context context.Context, d *schema.ResourceDat
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string))
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of toolchain (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/importer"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+)
+
+// cisImportPageSize is the maximum number of domains or DNS records per page
+const cisImportPageSize = 1000
+
+// ImportListers returns the listers of the CIS objects of the instance given
+// by the cis_id option, which can be restricted to a domain by the domain_id
+// option.
+func ImportListers() map[string]importer.Lister {
+	return map[string]importer.Lister{
+		"ibm_cis_domain":     listCisDomainImports,
+		"ibm_cis_dns_record": listCisDNSRecordImports,
+	}
+}
+
+// listCisDomains returns the domains of the CIS instance of options.
+func listCisDomains(meta interface{}, options map[string]string) (string, []zonesv1.ZoneDetails, error) {
+	crn := options[cisID]
+	if crn == "" {
+		return "", nil, fmt.Errorf("[ERROR] The %s option is required to list the CIS objects", cisID)
+	}
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return "", nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+
+	var zones []zonesv1.ZoneDetails
+	for page := int64(1); ; page++ {
+		opt := cisClient.NewListZonesOptions()
+		opt.SetPage(page)
+		opt.SetPerPage(cisImportPageSize)
+		result, resp, err := cisClient.ListZones(opt)
+		if err != nil {
+			return "", nil, fmt.Errorf("[ERROR] Error listing the domains of %s: %s\n%s", crn, err, resp)
+		}
+		zones = append(zones, result.Result...)
+		if len(result.Result) == 0 || result.ResultInfo == nil || len(zones) >= flex.IntValue(result.ResultInfo.TotalCount) {
+			break
+		}
+	}
+
+	if domainID := options[cisDomainID]; domainID != "" {
+		// The domain ID can be given with or without the CRN
+		zoneID, _, _ := flex.ConvertTftoCisTwoVar(domainID)
+		for _, zone := range zones {
+			if *zone.ID == zoneID {
+				return crn, []zonesv1.ZoneDetails{zone}, nil
+			}
+		}
+		return "", nil, fmt.Errorf("[ERROR] The domain %s is not a domain of %s", domainID, crn)
+	}
+	return crn, zones, nil
+}
+
+func listCisDomainImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	crn, zones, err := listCisDomains(meta, options)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	for _, zone := range zones {
+		items = append(items, importer.Item{ResourceType: "ibm_cis_domain", ID: flex.ConvertCisToTfTwoVar(*zone.ID, crn), Name: *zone.Name})
+	}
+	return items, nil
+}
+
+// listCisDNSRecordImports lists the DNS records of the domains, which are
+// named after their name and type.
+func listCisDNSRecordImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	crn, zones, err := listCisDomains(meta, options)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+
+	var items []importer.Item
+	for _, zone := range zones {
+		sess.ZoneIdentifier = zone.ID
+		count := 0
+		for page := int64(1); ; page++ {
+			opt := sess.NewListAllDnsRecordsOptions()
+			opt.SetPage(page)
+			opt.SetPerPage(cisImportPageSize)
+			result, response, err := sess.ListAllDnsRecords(opt)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error listing the dns records of %s: %s\n%s", *zone.Name, err, response)
+			}
+			for _, record := range result.Result {
+				items = append(items, importer.Item{
+					ResourceType: "ibm_cis_dns_record",
+					ID:           flex.ConvertCisToTfThreeVar(*record.ID, *zone.ID, crn),
+					Name:         fmt.Sprintf("%s_%s", *record.Name, *record.Type),
+				})
+			}
+			count += len(result.Result)
+			if len(result.Result) == 0 || result.ResultInfo == nil || count >= flex.IntValue(result.ResultInfo.TotalCount) {
+				break
+			}
+		}
+	}
+	return items, nil
+}
@@ -241,7 +241,7 @@ func ResourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of ibm cis (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -375,7 +375,7 @@ func ResourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of CIS (%s) tags: %s", d.Id(), err)
 		}
 	}
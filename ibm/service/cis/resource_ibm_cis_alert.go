@@ -6,7 +6,6 @@ package cis
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -243,7 +242,7 @@ func ResourceIBMCISAlertPolicyRead(d *schema.ResourceData, meta interface{}) err
 	d.Set(cisAlertType, *result.Result.AlertType)
 	d.Set(cisGLBPoolEnabled, *result.Result.Enabled)
 	if err := d.Set(cisAlertMechanisms, flattenCISMechanism(*result.Result.Mechanisms)); err != nil {
-		log.Printf("[WARN] Error setting mechanism for alert policies %q: %s", d.Id(), err)
+		flex.AddWarningf(d, "Error setting mechanism for alert policies %q: %s", d.Id(), err)
 	}
 
 	filterOpt, err := json.Marshal(result.Result.Filters)
@@ -328,7 +328,7 @@ func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error
 	d.Set(cisGLBHealthCheckCreatedOn, result.Result.CreatedOn)
 	d.Set(cisGLBHealthCheckModifiedOn, result.Result.ModifiedOn)
 	if err := d.Set(cisGLBHealthCheckHeaders, flattenLoadBalancerMonitorHeader(result.Result.Header)); err != nil {
-		log.Printf("[WARN] Error setting header for load balancer monitor %q: %s", d.Id(), err)
+		flex.AddWarningf(d, "Error setting header for load balancer monitor %q: %s", d.Id(), err)
 	}
 
 	return nil
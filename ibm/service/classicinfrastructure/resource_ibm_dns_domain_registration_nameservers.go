@@ -20,6 +20,9 @@ func ResourceIBMDNSDomainRegistrationNameservers() *schema.Resource {
 		Read:   resourceIBMDNSDomainRegistrationNSRead,
 		Update: resourceIBMDNSDomainRegistrationNSUpdate,
 		Delete: resourceIBMDNSDomainRegistrationNSDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMDNSDomainRegistrationNSImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"dns_registration_id": {
 				Type:        schema.TypeString,
@@ -181,6 +184,16 @@ func resourceIBMDNSDomainRegistrationNSRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// An imported resource has no original name servers, so that its delete
+// leaves the name servers as is.
+func resourceIBMDNSDomainRegistrationNSImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err != nil {
+		return nil, fmt.Errorf("[ERROR] The DNS registration ID must be an integer: %s", err)
+	}
+	d.Set("dns_registration_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 // No delete on IBM Cloud
 func resourceIBMDNSDomainRegistrationNSUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
@@ -24,6 +25,9 @@ func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 		Read:   resourceIBMNetworkInterfaceSGAttachmentRead,
 		Delete: resourceIBMNetworkInterfaceSGAttachmentDelete,
 		Exists: resourceIBMNetworkInterfaceSGAttachmentExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMNetworkInterfaceSGAttachmentImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 		},
@@ -174,6 +178,29 @@ func resourceIBMNetworkInterfaceSGAttachmentExists(d *schema.ResourceData, meta
 	return false, fmt.Errorf("[ERROR] No association found between security group %d and network interface %d", sgID, interfaceID)
 }
 
+// resourceIBMNetworkInterfaceSGAttachmentImport imports an attachment with the
+// ID <security_group_id>/<network_interface_id>, or the
+// <security_group_id>_<network_interface_id> ID of its state.
+func resourceIBMNetworkInterfaceSGAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	if strings.Contains(id, "/") {
+		parts, err := flex.ImportIdParts(id, "security_group_id", "network_interface_id")
+		if err != nil {
+			return nil, err
+		}
+		id = fmt.Sprintf("%s_%s", parts[0], parts[1])
+	}
+	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(id)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+	d.Set("security_group_id", sgID)
+	d.Set("network_interface_id", interfaceID)
+	d.Set("soft_reboot", true)
+	return []*schema.ResourceData{d}, nil
+}
+
 func decomposeNetworkSGAttachmentID(attachmentID string) (sgID, interfaceID int, err error) {
 	ids := strings.Split(attachmentID, "_")
 	if len(ids) != 2 {
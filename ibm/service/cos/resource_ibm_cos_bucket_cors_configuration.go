@@ -0,0 +1,254 @@
+package cos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMCOSBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketCorsConfigurationCreate,
+		ReadContext:   resourceIBMCOSBucketCorsConfigurationRead,
+		UpdateContext: resourceIBMCOSBucketCorsConfigurationUpdate,
+		DeleteContext: resourceIBMCOSBucketCorsConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCOSBucketCRN,
+				Description:  "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    100,
+				Description: "The cross-origin resource sharing (CORS) rules of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The headers allowed in a preflight OPTIONS request, in the Access-Control-Request-Headers header.",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}, false),
+							},
+							Description: "The HTTP methods that the origins are allowed to execute: GET, PUT, POST, DELETE or HEAD.",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The origins allowed to access the bucket, such as https://www.example.com, or *.",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The headers of the response that the browsers can access.",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The time in seconds that the browsers can cache the response of a preflight request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corsRulesSet(corsRuleList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, l := range corsRuleList {
+		ruleMap, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cors_rule := s3.CORSRule{
+			AllowedHeaders: aws.StringSlice(flex.ExpandStringList(ruleMap["allowed_headers"].([]interface{}))),
+			AllowedMethods: aws.StringSlice(flex.ExpandStringList(ruleMap["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(flex.ExpandStringList(ruleMap["allowed_origins"].([]interface{}))),
+			ExposeHeaders:  aws.StringSlice(flex.ExpandStringList(ruleMap["expose_headers"].([]interface{}))),
+		}
+		if maxAgeSeconds, ok := ruleMap["max_age_seconds"].(int); ok && maxAgeSeconds != 0 {
+			cors_rule.MaxAgeSeconds = aws.Int64(int64(maxAgeSeconds))
+		}
+		rules = append(rules, &cors_rule)
+	}
+	return rules
+}
+
+func corsRulesGet(rules []*s3.CORSRule) []map[string]interface{} {
+	corsRules := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		corsRule := map[string]interface{}{
+			"allowed_headers": aws.StringValueSlice(rule.AllowedHeaders),
+			"allowed_methods": aws.StringValueSlice(rule.AllowedMethods),
+			"allowed_origins": aws.StringValueSlice(rule.AllowedOrigins),
+			"expose_headers":  aws.StringValueSlice(rule.ExposeHeaders),
+			"max_age_seconds": int(aws.Int64Value(rule.MaxAgeSeconds)),
+		}
+		corsRules = append(corsRules, corsRule)
+	}
+	return corsRules
+}
+
+// parseCOSBucketCRN returns the name of the bucket of bucketCRN and the CRN of
+// its instance, as the S3 client takes it.
+func parseCOSBucketCRN(bucketCRN string) (bucketName string, instanceCRN string, err error) {
+	crn, err := flex.Parse(bucketCRN)
+	if err != nil || crn.ResourceType != "bucket" || crn.Resource == "" {
+		return "", "", fmt.Errorf("[ERROR] Invalid bucket CRN %q, expected the CRN of a resource of type bucket", bucketCRN)
+	}
+	bucketName = crn.Resource
+	crn.ResourceType, crn.Resource = "", ""
+	return bucketName, crn.String(), nil
+}
+
+func validateCOSBucketCRN(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := parseCOSBucketCRN(v.(string)); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
+func resourceIBMCOSBucketCorsConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName, instanceCRN, err := parseCOSBucketCRN(d.Get("bucket_crn").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketCorsConfigurationUpdate(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName, instanceCRN, err := parseCOSBucketCRN(d.Get("bucket_crn").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	if d.IsNewResource() || d.HasChange("cors_rule") {
+		putBucketCorsInput := s3.PutBucketCorsInput{
+			Bucket: aws.String(bucketName),
+			CORSConfiguration: &s3.CORSConfiguration{
+				CORSRules: corsRulesSet(d.Get("cors_rule").([]interface{})),
+			},
+		}
+		_, err = s3Client.PutBucketCors(&putBucketCorsInput)
+		if err != nil {
+			return diag.Errorf("Failed to put CORS configuration on the COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketCorsConfigurationRead(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := parseWebsiteId(d.Id(), "bucketCRN")
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	getBucketCorsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetBucketCors(getBucketCorsInput)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NoSuchCORSConfiguration" && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error getting CORS Configuration for the bucket %s, %v", bucketName, err)
+	}
+	if err = d.Set("cors_rule", corsRulesGet(output.CORSRules)); err != nil {
+		return diag.Errorf("[ERROR] Error setting cors_rule: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketCorsConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	deleteBucketCorsInput := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeleteBucketCors(deleteBucketCorsInput)
+	if err != nil {
+		return diag.Errorf("failed to delete the CORS configuration on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
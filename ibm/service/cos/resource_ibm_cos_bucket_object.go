@@ -6,7 +6,9 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -20,15 +22,22 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/client"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// contentMD5MetadataKey is the metadata holding the MD5 hexdigest of the
+// content of an object.
+const contentMD5MetadataKey = "Content-Md5"
+
 func ResourceIBMCOSBucketObject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMCOSBucketObjectCreate,
@@ -151,6 +160,27 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Optional:    true,
 				Description: "Redirect a request to another object or an URL",
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "The size in MiB of the parts of a multipart upload. The content larger than a part is uploaded in parts.",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The number of parts of a multipart upload that are uploaded in parallel.",
+			},
+			"part_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      client.DefaultRetryerMaxNumRetries,
+				ValidateFunc: validation.IntBetween(0, 20),
+				Description:  "The number of retries of a failed part of a multipart upload. A multipart upload that still fails is aborted, and the next apply uploads the whole content again.",
+			},
 		},
 	}
 }
@@ -175,46 +205,8 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 
 	objectKey := d.Get("key").(string)
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
-	//if website redirect location if given for a an object
-	if v, ok := d.GetOk("website_redirect"); ok {
-		putInput.WebsiteRedirectLocation = aws.String(v.(string))
-	}
-
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := putCOSBucketObject(ctx, d, s3Client, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
 	}
 	if v, ok := d.GetOk("object_lock_mode"); ok {
 		if d, ok := d.GetOk("object_lock_retain_until_date"); ok {
@@ -294,7 +286,7 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("content_length", out.ContentLength)
 	d.Set("content_type", out.ContentType)
-	d.Set("etag", strings.Trim(aws.StringValue(out.ETag), `"`))
+	d.Set("etag", cosObjectETag(out))
 	if out.LastModified != nil {
 		d.Set("last_modified", out.LastModified.Format(time.RFC1123))
 	} else {
@@ -362,53 +354,10 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
-
-		var body io.ReadSeeker
-
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-			}
-
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
-		}
-
-		objectKey := d.Get("key").(string)
-
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
-		}
-		if d.HasChange("website_redirect") {
-			if v, ok := d.GetOk("website_redirect"); ok {
-				putInput.WebsiteRedirectLocation = aws.String(v.(string))
-			}
+	if d.HasChanges("content", "content_base64", "content_file", "etag", "website_redirect") {
+		if err := putCOSBucketObject(ctx, d, s3Client, bucketName, objectKey); err != nil {
+			return diag.FromErr(err)
 		}
-
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
-		}
-
 	}
 	if d.HasChange("object_lock_legal_hold_status") {
 		putObjectLegalHoldInput := &s3.PutObjectLegalHoldInput{
@@ -476,6 +425,109 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// putCOSBucketObject uploads the content of the object, streaming content_file
+// instead of reading it in memory.
+func putCOSBucketObject(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	var body io.ReadSeeker
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		body = bytes.NewReader([]byte(content))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		body = bytes.NewReader(contentRaw)
+	} else if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+
+		body = file
+		defer func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}()
+	} else {
+		body = bytes.NewReader([]byte{})
+	}
+
+	uploadInput := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	}
+	//if website redirect location if given for a an object
+	if v, ok := d.GetOk("website_redirect"); ok {
+		uploadInput.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+
+	partSize := int64(d.Get("part_size").(int)) * 1024 * 1024
+	return putCOSObject(ctx, s3Client, uploadInput, body, partSize, d.Get("upload_concurrency").(int), d.Get("part_retries").(int))
+}
+
+// putCOSObject uploads body to the object of input with uploadCOSObject. The
+// MD5 hexdigest of body is stored in the contentMD5MetadataKey metadata, since
+// the ETag of an object uploaded in parts is not the MD5 hexdigest of its
+// content.
+func putCOSObject(ctx context.Context, s3Client *s3.S3, input *s3manager.UploadInput, body io.ReadSeeker, partSize int64, concurrency int, maxRetries int) error {
+	objectKey := aws.StringValue(input.Key)
+	bucketName := aws.StringValue(input.Bucket)
+	hash := md5.New()
+	if _, err := io.Copy(hash, body); err != nil {
+		return fmt.Errorf("[ERROR] Error reading the content of object (%s): %s", objectKey, err)
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("[ERROR] Error reading the content of object (%s): %s", objectKey, err)
+	}
+
+	input.Body = body
+	if input.Metadata == nil {
+		input.Metadata = map[string]*string{}
+	}
+	input.Metadata[contentMD5MetadataKey] = aws.String(hex.EncodeToString(hash.Sum(nil)))
+	if err := uploadCOSObject(ctx, s3Client, input, partSize, concurrency, maxRetries); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// uploadCOSObject uploads the body to the object key, with a single PutObject
+// when it is smaller than partSize bytes, or else with a multipart upload of
+// concurrency parts at a time. A failed part is retried up to maxRetries times
+// without uploading the other parts again. A failed upload is aborted, so the
+// next upload starts over.
+func uploadCOSObject(ctx context.Context, s3Client *s3.S3, input *s3manager.UploadInput, partSize int64, concurrency int, maxRetries int) error {
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = partSize
+		u.Concurrency = concurrency
+		u.RequestOptions = append(u.RequestOptions, func(r *request.Request) {
+			r.Retryer = client.DefaultRetryer{NumMaxRetries: maxRetries}
+		})
+	})
+	_, err := uploader.UploadWithContext(ctx, input)
+	return err
+}
+
+// cosObjectETag returns the MD5 hexdigest of the content of the object: its
+// ETag, or the contentMD5MetadataKey metadata of an object uploaded in parts.
+func cosObjectETag(out *s3.HeadObjectOutput) string {
+	etag := strings.Trim(aws.StringValue(out.ETag), `"`)
+	if strings.Contains(etag, "-") {
+		for key, value := range out.Metadata {
+			if strings.EqualFold(key, contentMD5MetadataKey) && value != nil {
+				return *value
+			}
+		}
+	}
+	return etag
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		hostUrl := "cloud-object-storage.appdomain.cloud"
@@ -4,9 +4,12 @@
 package cos_test
 
 import (
+	"bytes"
+	"crypto/md5"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"regexp"
 	"testing"
 	"time"
@@ -70,6 +73,35 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCOSBucketObject_Multipart(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile := filepath.Join(t.TempDir(), "multipart.bin")
+	objectFileBody := bytes.Repeat([]byte("0123456789abcdef"), 12*1024*1024/16)
+	if err := ioutil.WriteFile(objectFile, objectFileBody, 0644); err != nil {
+		t.Fatal(err)
+	}
+	objectFileMD5 := fmt.Sprintf("%x", md5.Sum(objectFileBody))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, filepath.ToSlash(objectFile), objectFileMD5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", fmt.Sprintf("%d", len(objectFileBody))),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "etag", objectFileMD5),
+				),
+			},
+			{
+				Config:   testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, filepath.ToSlash(objectFile), objectFileMD5),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccIBMCOSBucketObject_VersioningEnabled(t *testing.T) {
 	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
 	key := "plaintext.txt"
@@ -321,6 +353,26 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 		}`, name, instanceCRN, objectFile)
 }
 
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string, objectFileMD5 string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn         = ibm_cos_bucket.testacc.crn
+			bucket_location    = ibm_cos_bucket.testacc.region_location
+			key                = "%[1]s.bin"
+			content_file       = "%[3]s"
+			etag               = "%[4]s"
+			part_size          = 5
+			upload_concurrency = 2
+			part_retries       = 5
+		}`, name, instanceCRN, objectFile, objectFileMD5)
+}
+
 func testAccIBMCOSBucketBucketObject_Versioning_Enabled(name string, key string, instanceCRN string, objectBody1 string, objectBody2 string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
@@ -0,0 +1,516 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/client"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The objects of a sync are deleted by batches of deleteObjectsBatchSize keys,
+// the maximum of a DeleteObjects request.
+const deleteObjectsBatchSize = 1000
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCOSBucketCRN,
+				Description:  "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The local directory whose files are uploaded to the bucket.",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "The prefix of the object keys, prepended to the path of the files relative to the source directory.",
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateSyncGlobPattern},
+				Description: "The glob patterns of the files to upload, relative to the source directory. By default, all the files are uploaded.",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateSyncGlobPattern},
+				Description: "The glob patterns of the files not to upload, relative to the source directory.",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The content types of the objects by file extension, such as .html. The content type of the other extensions is guessed.",
+			},
+			"delete_orphans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the key prefix that do not match a file of the source directory.",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(5, 5120),
+				Description:  "The size in MiB of the parts of the multipart uploads. The files larger than a part are uploaded in parts.",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The MD5 hexdigest of the synchronized objects, by object key.",
+			},
+		},
+	}
+}
+
+func getObjectsSyncId(bucketCRN string, keyPrefix string, bucketLocation string) string {
+	return fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, keyPrefix, bucketLocation)
+}
+
+// parseObjectsSyncId returns the info part of the ID of an objects sync, or
+// an empty string when the ID is malformed.
+func parseObjectsSyncId(id string, info string) string {
+	splitID := strings.SplitN(id, ":sync:", 2)
+	if len(splitID) != 2 {
+		return ""
+	}
+	bucketCRN := splitID[0]
+	locationIndex := strings.LastIndex(splitID[1], ":location:")
+	if locationIndex < 0 {
+		return ""
+	}
+	bucketName, instanceCRN, err := parseCOSBucketCRN(bucketCRN)
+	if err != nil {
+		return ""
+	}
+
+	switch info {
+	case "instanceCRN":
+		return instanceCRN
+	case "bucketCRN":
+		return bucketCRN
+	case "bucketName":
+		return bucketName
+	case "keyPrefix":
+		return splitID[1][:locationIndex]
+	case "bucketLocation":
+		return splitID[1][locationIndex+len(":location:"):]
+	}
+	return ""
+}
+
+// syncGlobMatch reports whether the slash separated name matches the pattern.
+// A * matches any sequence of characters but /, and a ** path element matches
+// any number of directories.
+func syncGlobMatch(pattern, name string) bool {
+	return matchGlobElems(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchGlobElems(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchGlobElems(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		// The patterns are checked by validateSyncGlobPattern
+		if matched, err := path.Match(pattern[0], name[0]); err != nil || !matched {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+func validateSyncGlobPattern(v interface{}, k string) (ws []string, errors []error) {
+	pattern := v.(string)
+	if pattern == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+	for _, elem := range strings.Split(pattern, "/") {
+		if elem == "**" {
+			continue
+		}
+		if _, err := path.Match(elem, ""); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains the malformed glob pattern %q: %s", k, pattern, err))
+			return
+		}
+	}
+	return
+}
+
+// syncLocalFiles returns the MD5 hexdigest of the files of the source
+// directory matching the include and exclude patterns, by object key.
+func syncLocalFiles(source, keyPrefix string, include, exclude []string) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.WalkDir(source, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		relPath, err := filepath.Rel(source, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		included := len(include) == 0
+		for _, pattern := range include {
+			if syncGlobMatch(pattern, relPath) {
+				included = true
+				break
+			}
+		}
+		for _, pattern := range exclude {
+			if syncGlobMatch(pattern, relPath) {
+				included = false
+				break
+			}
+		}
+		if !included {
+			return nil
+		}
+		hash, err := fileMD5(filePath)
+		if err != nil {
+			return err
+		}
+		files[keyPrefix+relPath] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading the source directory %s: %s", source, err)
+	}
+	return files, nil
+}
+
+func fileMD5(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// syncContentType returns the content type of the object key, from the
+// content types by extension of the configuration or else from its extension.
+func syncContentType(key string, contentTypes map[string]interface{}) string {
+	ext := strings.ToLower(path.Ext(key))
+	if ext == "" {
+		return ""
+	}
+	for extension, contentType := range contentTypes {
+		if strings.ToLower("."+strings.TrimPrefix(extension, ".")) == ext {
+			return contentType.(string)
+		}
+	}
+	return mime.TypeByExtension(ext)
+}
+
+func syncSourceFiles(d *schema.ResourceDiff) (map[string]string, error) {
+	return syncLocalFiles(d.Get("source").(string), d.Get("key_prefix").(string),
+		flex.ExpandStringList(d.Get("include").([]interface{})), flex.ExpandStringList(d.Get("exclude").([]interface{})))
+}
+
+// resourceIBMCOSBucketObjectsSyncCustomizeDiff plans the changes of the
+// source directory as a change of the files attribute.
+func resourceIBMCOSBucketObjectsSyncCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source", "key_prefix", "include", "exclude"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("files")
+		}
+	}
+	files, err := syncSourceFiles(d)
+	if err != nil {
+		return err
+	}
+	oldFiles := map[string]string{}
+	for key, hash := range d.Get("files").(map[string]interface{}) {
+		oldFiles[key] = hash.(string)
+	}
+	if !reflect.DeepEqual(files, oldFiles) {
+		return d.SetNew("files", files)
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	d.SetId(getObjectsSyncId(bucketCRN, d.Get("key_prefix").(string), bucketLocation))
+	return resourceIBMCOSBucketObjectsSyncUpdate(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName, instanceCRN, err := parseCOSBucketCRN(d.Get("bucket_crn").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	source := d.Get("source").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	files, err := syncLocalFiles(source, keyPrefix,
+		flex.ExpandStringList(d.Get("include").([]interface{})), flex.ExpandStringList(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	oldFilesRaw, _ := d.GetChange("files")
+	oldFiles := oldFilesRaw.(map[string]interface{})
+	contentTypes := d.Get("content_types").(map[string]interface{})
+	partSize := int64(d.Get("part_size").(int)) * 1024 * 1024
+	uploadAll := d.IsNewResource() || d.HasChange("content_types")
+
+	for key, hash := range files {
+		if oldHash, ok := oldFiles[key]; ok && oldHash.(string) == hash && !uploadAll {
+			continue
+		}
+		filePath := filepath.Join(source, filepath.FromSlash(strings.TrimPrefix(key, keyPrefix)))
+		file, err := os.Open(filePath)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", filePath, err))
+		}
+		uploadInput := &s3manager.UploadInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+		}
+		if contentType := syncContentType(key, contentTypes); contentType != "" {
+			uploadInput.ContentType = aws.String(contentType)
+		}
+		log.Printf("[INFO] Uploading %s to COS bucket (%s) object (%s)", filePath, bucketName, key)
+		err = putCOSObject(ctx, s3Client, uploadInput, file, partSize, s3manager.DefaultUploadConcurrency, client.DefaultRetryerMaxNumRetries)
+		if closeErr := file.Close(); closeErr != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", filePath, closeErr)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// The objects of removed files, and the orphans found by Read, are deleted
+	var orphans []string
+	for key := range oldFiles {
+		if _, ok := files[key]; !ok {
+			orphans = append(orphans, key)
+		}
+	}
+	if err := deleteCOSObjects(s3Client, bucketName, orphans); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("files", files)
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := parseObjectsSyncId(d.Id(), "bucketCRN")
+	bucketName := parseObjectsSyncId(d.Id(), "bucketName")
+	bucketLocation := parseObjectsSyncId(d.Id(), "bucketLocation")
+	instanceCRN := parseObjectsSyncId(d.Id(), "instanceCRN")
+	keyPrefix := parseObjectsSyncId(d.Id(), "keyPrefix")
+	endpointType := d.Get("endpoint_type").(string)
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	d.Set("key_prefix", keyPrefix)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remoteObjects := map[string]string{}
+	listInput := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(keyPrefix),
+	}
+	err = s3Client.ListObjectsV2PagesWithContext(ctx, listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			remoteObjects[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket && !d.IsNewResource() {
+			log.Printf("[WARN] COS bucket (%s) not found, removing the objects sync from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the objects of COS bucket (%s): %s", bucketName, err))
+	}
+
+	// The deleted objects are dropped, and the changed ones get the MD5
+	// hexdigest of the object, so that the next plan uploads them again. The
+	// ETag of a multipart upload is not an MD5 hexdigest, so the hexdigest of
+	// these objects is read from their metadata, see cosObjectETag.
+	files := map[string]string{}
+	for key, hash := range d.Get("files").(map[string]interface{}) {
+		etag, ok := remoteObjects[key]
+		if !ok {
+			continue
+		}
+		if strings.Contains(etag, "-") {
+			head, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+				Bucket: aws.String(bucketName),
+				Key:    aws.String(key),
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error reading object (%s) in COS bucket (%s): %s", key, bucketName, err))
+			}
+			etag = cosObjectETag(head)
+		}
+		// An object uploaded in parts without the metadata is not compared
+		if !strings.Contains(etag, "-") {
+			hash = etag
+		}
+		files[key] = hash.(string)
+	}
+	if d.Get("delete_orphans").(bool) {
+		for key, etag := range remoteObjects {
+			if _, ok := files[key]; !ok {
+				files[key] = etag
+			}
+		}
+	}
+	d.Set("files", files)
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName := parseObjectsSyncId(d.Id(), "bucketName")
+	bucketLocation := parseObjectsSyncId(d.Id(), "bucketLocation")
+	instanceCRN := parseObjectsSyncId(d.Id(), "instanceCRN")
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var keys []string
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if err := deleteCOSObjects(s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// deleteCOSObjects deletes the current version of the objects keys.
+func deleteCOSObjects(conn *s3.S3, bucketName string, keys []string) error {
+	for start := 0; start < len(keys); start += deleteObjectsBatchSize {
+		end := start + deleteObjectsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		log.Printf("[INFO] Deleting %d objects of COS bucket (%s)", len(objects), bucketName)
+		output, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting the objects of COS bucket (%s): %s", bucketName, err)
+		}
+		if len(output.Errors) > 0 {
+			return fmt.Errorf("[ERROR] Error deleting object (%s) of COS bucket (%s): %s", aws.StringValue(output.Errors[0].Key), bucketName, aws.StringValue(output.Errors[0].Message))
+		}
+	}
+	return nil
+}
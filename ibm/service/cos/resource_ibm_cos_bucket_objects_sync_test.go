@@ -0,0 +1,101 @@
+package cos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Objects_Sync_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-sync%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+	source := t.TempDir()
+	writeSyncFile(t, source, "index.html", "<html>index</html>")
+	writeSyncFile(t, source, "css/site.css", "body {}")
+	writeSyncFile(t, source, "drafts/notes.txt", "not published")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_Objects_Sync_Basic(serviceName, bucketName, bucketRegion, bucketClass, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.site", "files.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.site", "files.site/css/site.css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeSyncFile(t, source, "index.html", "<html>updated index</html>")
+					writeSyncFile(t, source, "about.html", "<html>about</html>")
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckIBMCosBucket_Objects_Sync_Basic(serviceName, bucketName, bucketRegion, bucketClass, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.site", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.site", "files.site/about.html"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_objects_sync.site", "files.site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func writeSyncFile(t *testing.T, source, name, content string) {
+	filePath := filepath.Join(source, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckIBMCosBucket_Objects_Sync_Basic(cosServiceName string, bucketName string, region string, storageClass string, source string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+		force_delete         = true
+	}
+
+	resource "ibm_cos_bucket_objects_sync" "site" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		source          = "%s"
+		key_prefix      = "site/"
+		exclude         = ["drafts/**"]
+		content_types = {
+			".css" = "text/css; charset=utf-8"
+		}
+		delete_orphans = true
+	}
+	`, cosServiceName, bucketName, region, storageClass, filepath.ToSlash(source))
+}
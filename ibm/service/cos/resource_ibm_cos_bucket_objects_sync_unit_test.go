@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncGlobMatch(t *testing.T) {
+	testcases := []struct {
+		pattern string
+		name    string
+		matched bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"docs/*", "docs/index.html", true},
+		{"docs/*", "docs/api/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/api/index.html", true},
+		{"docs/**", "docs/api/index.html", true},
+		{"docs/**", "docs", true},
+		{"docs/**/index.html", "docs/index.html", true},
+		{"docs/**/index.html", "blog/index.html", false},
+		{"img/?.png", "img/a.png", true},
+		{"img/[ab].png", "img/c.png", false},
+		{"index.html", "index.html", true},
+		{"index.html", "index.htm", false},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.matched, syncGlobMatch(tc.pattern, tc.name), "%s matching %s", tc.pattern, tc.name)
+	}
+}
+
+func TestValidateSyncGlobPattern(t *testing.T) {
+	for _, pattern := range []string{"*.html", "**/*.css", "docs/[a-z]*/index.html"} {
+		_, errors := validateSyncGlobPattern(pattern, "include")
+		assert.Empty(t, errors, pattern)
+	}
+	for _, pattern := range []string{"", "[", "docs/[a-/*.html", "docs/\\"} {
+		_, errors := validateSyncGlobPattern(pattern, "include")
+		assert.Len(t, errors, 1, pattern)
+	}
+}
+
+func TestSyncContentType(t *testing.T) {
+	contentTypes := map[string]interface{}{
+		"md":    "text/markdown",
+		".JSON": "application/vnd.api+json",
+	}
+	assert.Equal(t, "text/markdown", syncContentType("docs/README.md", contentTypes))
+	assert.Equal(t, "application/vnd.api+json", syncContentType("data/items.json", contentTypes))
+	assert.Equal(t, "text/html; charset=utf-8", syncContentType("index.HTML", contentTypes))
+	assert.Equal(t, "image/png", syncContentType("img/logo.png", nil))
+	assert.Equal(t, "", syncContentType("LICENSE", contentTypes))
+	assert.Equal(t, "", syncContentType("archive.unknown-extension", nil))
+}
+
+func TestParseObjectsSyncId(t *testing.T) {
+	bucketCRN := "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:bucket:my-bucket"
+	id := getObjectsSyncId(bucketCRN, "site/:location:/", "us-south")
+
+	assert.Equal(t, bucketCRN, parseObjectsSyncId(id, "bucketCRN"))
+	assert.Equal(t, "my-bucket", parseObjectsSyncId(id, "bucketName"))
+	assert.Equal(t, "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance::", parseObjectsSyncId(id, "instanceCRN"))
+	assert.Equal(t, "site/:location:/", parseObjectsSyncId(id, "keyPrefix"))
+	assert.Equal(t, "us-south", parseObjectsSyncId(id, "bucketLocation"))
+	assert.Equal(t, "", parseObjectsSyncId(id, "unknown"))
+
+	// An empty key prefix syncs the whole bucket
+	id = getObjectsSyncId(bucketCRN, "", "eu-de")
+	assert.Equal(t, "", parseObjectsSyncId(id, "keyPrefix"))
+	assert.Equal(t, "eu-de", parseObjectsSyncId(id, "bucketLocation"))
+
+	for _, malformed := range []string{"", bucketCRN, bucketCRN + ":sync:site/", "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance:::sync::location:us-south"} {
+		assert.Equal(t, "", parseObjectsSyncId(malformed, "bucketName"), malformed)
+		assert.Equal(t, "", parseObjectsSyncId(malformed, "bucketLocation"), malformed)
+	}
+}
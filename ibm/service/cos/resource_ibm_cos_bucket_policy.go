@@ -0,0 +1,241 @@
+package cos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// COS does not support the S3 bucket policies: the access to a bucket is
+// granted by IAM access policies, whose resource is the bucket.
+func ResourceIBMCOSBucketPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketPolicyCreate,
+		ReadContext:   resourceIBMCOSBucketPolicyRead,
+		UpdateContext: resourceIBMCOSBucketPolicyUpdate,
+		DeleteContext: resourceIBMCOSBucketPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCOSBucketCRN,
+				Description:  "COS bucket CRN",
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The roles granted on the bucket, such as Reader, Writer, Content Reader or Object Writer.",
+			},
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"iam_id", "access_group_id"},
+				Description:  "The IAM ID of the user, service ID or trusted profile granted the roles.",
+			},
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the access group granted the roles.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the policy.",
+			},
+		},
+	}
+}
+
+// bucketPolicyOptions returns the subjects, roles and resources of the access
+// policy of the bucket.
+func bucketPolicyOptions(d *schema.ResourceData, meta interface{}) ([]iampolicymanagementv1.PolicySubject, []iampolicymanagementv1.PolicyRole, []iampolicymanagementv1.PolicyResource, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	crn, err := flex.Parse(bucketCRN)
+	if err != nil || crn.ResourceType != "bucket" || crn.ScopeType != "a" {
+		return nil, nil, nil, fmt.Errorf("[ERROR] Invalid bucket CRN %s", bucketCRN)
+	}
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   core.StringPtr(crn.Scope),
+		ServiceName: core.StringPtr("cloud-object-storage"),
+	}
+	roleList, resp, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil || roleList == nil {
+		return nil, nil, nil, fmt.Errorf("[ERROR] Error listing the roles of cloud-object-storage: %s, %s", err, resp)
+	}
+	roles, err := flex.GetRolesFromRoleNames(flex.ExpandStringList(d.Get("roles").(*schema.Set).List()), flex.MapRoleListToPolicyRoles(*roleList))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	subjectAttribute := iampolicymanagementv1.SubjectAttribute{
+		Name:  core.StringPtr("iam_id"),
+		Value: core.StringPtr(d.Get("iam_id").(string)),
+	}
+	if accessGroupID := d.Get("access_group_id").(string); accessGroupID != "" {
+		subjectAttribute.Name = core.StringPtr("access_group_id")
+		subjectAttribute.Value = core.StringPtr(accessGroupID)
+	}
+	subjects := []iampolicymanagementv1.PolicySubject{
+		{Attributes: []iampolicymanagementv1.SubjectAttribute{subjectAttribute}},
+	}
+
+	resources := []iampolicymanagementv1.PolicyResource{
+		{
+			Attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("accountId"), Value: core.StringPtr(crn.Scope)},
+				{Name: core.StringPtr("serviceName"), Value: core.StringPtr(crn.ServiceName)},
+				{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr(crn.ServiceInstance)},
+				{Name: core.StringPtr("resourceType"), Value: core.StringPtr("bucket")},
+				{Name: core.StringPtr("resource"), Value: core.StringPtr(crn.Resource)},
+			},
+		},
+	}
+	return subjects, roles, resources, nil
+}
+
+func resourceIBMCOSBucketPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	subjects, roles, resources, err := bucketPolicyOptions(d, meta)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions("access", subjects, roles, resources)
+	if description, ok := d.GetOk("description"); ok {
+		createPolicyOptions.SetDescription(description.(string))
+	}
+	policy, resp, err := iamPolicyManagementClient.CreatePolicyWithContext(ctx, createPolicyOptions)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error creating the access policy of the bucket %s: %s, %s", d.Get("bucket_crn").(string), err, resp)
+	}
+	d.SetId(*policy.ID)
+	return resourceIBMCOSBucketPolicyRead(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(d.Id())
+	policy, resp, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving the access policy %s: %s, %s", d.Id(), err, resp)
+	}
+	if policy.State != nil && *policy.State == "deleted" {
+		d.SetId("")
+		return nil
+	}
+
+	roles := make([]string, 0, len(policy.Roles))
+	for _, role := range policy.Roles {
+		if role.DisplayName != nil {
+			roles = append(roles, *role.DisplayName)
+		}
+	}
+	d.Set("roles", roles)
+	d.Set("description", policy.Description)
+	for _, subject := range policy.Subjects {
+		for _, attribute := range subject.Attributes {
+			switch *attribute.Name {
+			case "iam_id":
+				d.Set("iam_id", attribute.Value)
+			case "access_group_id":
+				d.Set("access_group_id", attribute.Value)
+			}
+		}
+	}
+
+	// The bucket CRN of an imported policy is made from its resource
+	if d.Get("bucket_crn").(string) == "" {
+		crn := flex.CRN{
+			Scheme:       "crn",
+			Version:      "v1",
+			CName:        "bluemix",
+			CType:        "public",
+			Region:       "global",
+			ScopeType:    "a",
+			ResourceType: "bucket",
+		}
+		for _, resource := range policy.Resources {
+			for _, attribute := range resource.Attributes {
+				switch *attribute.Name {
+				case "accountId":
+					crn.Scope = *attribute.Value
+				case "serviceName":
+					crn.ServiceName = *attribute.Value
+				case "serviceInstance":
+					crn.ServiceInstance = *attribute.Value
+				case "resource":
+					crn.Resource = *attribute.Value
+				}
+			}
+		}
+		if crn.ServiceName != "cloud-object-storage" || crn.Resource == "" {
+			return diag.Errorf("[ERROR] The access policy %s is not the policy of a bucket", d.Id())
+		}
+		d.Set("bucket_crn", crn.String())
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("roles", "description") {
+		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return diag.Errorf("%v", err)
+		}
+		subjects, roles, resources, err := bucketPolicyOptions(d, meta)
+		if err != nil {
+			return diag.Errorf("%v", err)
+		}
+		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(d.Id())
+		_, resp, err := iamPolicyManagementClient.GetPolicyWithContext(ctx, getPolicyOptions)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error retrieving the access policy %s: %s, %s", d.Id(), err, resp)
+		}
+		replacePolicyOptions := iamPolicyManagementClient.NewReplacePolicyOptions(d.Id(), resp.Headers.Get("ETag"), "access", subjects, roles, resources)
+		replacePolicyOptions.SetDescription(d.Get("description").(string))
+		_, resp, err = iamPolicyManagementClient.ReplacePolicyWithContext(ctx, replacePolicyOptions)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating the access policy %s: %s, %s", d.Id(), err, resp)
+		}
+	}
+	return resourceIBMCOSBucketPolicyRead(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(d.Id())
+	resp, err := iamPolicyManagementClient.DeletePolicyWithContext(ctx, deletePolicyOptions)
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return diag.Errorf("[ERROR] Error deleting the access policy %s: %s, %s", d.Id(), err, resp)
+	}
+	return nil
+}
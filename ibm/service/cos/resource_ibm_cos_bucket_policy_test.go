@@ -0,0 +1,79 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Policy_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-policy%d", acctest.RandIntRange(10, 100))
+	accessGroupName := fmt.Sprintf("terraform-cos-readers%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_Policy_Basic(serviceName, bucketName, bucketRegion, bucketClass, accessGroupName, `["Content Reader"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_cos_bucket_policy.policy", "access_group_id", "ibm_iam_access_group.group", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_Policy_Basic(serviceName, bucketName, bucketRegion, bucketClass, accessGroupName, `["Content Reader", "Object Writer"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_policy.policy", "roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_Policy_Basic(cosServiceName string, bucketName string, region string, storageClass string, accessGroupName string, roles string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+	}
+
+	resource "ibm_iam_access_group" "group" {
+		name = "%s"
+	}
+
+	resource "ibm_cos_bucket_policy" "policy" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		access_group_id = ibm_iam_access_group.group.id
+		roles           = %s
+		description     = "Read access to the bucket"
+	}
+	`, cosServiceName, bucketName, region, storageClass, accessGroupName, roles)
+}
@@ -0,0 +1,169 @@
+package cos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketPublicAccessBlock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketPublicAccessBlockCreate,
+		ReadContext:   resourceIBMCOSBucketPublicAccessBlockRead,
+		UpdateContext: resourceIBMCOSBucketPublicAccessBlockUpdate,
+		DeleteContext: resourceIBMCOSBucketPublicAccessBlockDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCOSBucketCRN,
+				Description:  "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"block_public_acls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reject the requests that set a public ACL on the bucket or its objects.",
+			},
+			"ignore_public_acls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Ignore the public ACLs of the bucket and its objects.",
+			},
+		},
+	}
+}
+
+func resourceIBMCOSBucketPublicAccessBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName, instanceCRN, err := parseCOSBucketCRN(d.Get("bucket_crn").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
+	d.SetId(bktID)
+	return resourceIBMCOSBucketPublicAccessBlockUpdate(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessBlockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName, instanceCRN, err := parseCOSBucketCRN(d.Get("bucket_crn").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	if d.IsNewResource() || d.HasChanges("block_public_acls", "ignore_public_acls") {
+		putPublicAccessBlockInput := s3.PutPublicAccessBlockInput{
+			Bucket: aws.String(bucketName),
+			PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+				BlockPublicAcls:  aws.Bool(d.Get("block_public_acls").(bool)),
+				IgnorePublicAcls: aws.Bool(d.Get("ignore_public_acls").(bool)),
+			},
+		}
+		_, err = s3Client.PutPublicAccessBlock(&putPublicAccessBlockInput)
+		if err != nil {
+			return diag.Errorf("Failed to put public access block on the COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketPublicAccessBlockRead(ctx, d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketCRN := parseWebsiteId(d.Id(), "bucketCRN")
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	getPublicAccessBlockInput := &s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	}
+	output, err := s3Client.GetPublicAccessBlock(getPublicAccessBlockInput)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NoSuchPublicAccessBlockConfiguration" && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error getting public access block for the bucket %s, %v", bucketName, err)
+	}
+	if output.PublicAccessBlockConfiguration != nil {
+		d.Set("block_public_acls", aws.BoolValue(output.PublicAccessBlockConfiguration.BlockPublicAcls))
+		d.Set("ignore_public_acls", aws.BoolValue(output.PublicAccessBlockConfiguration.IgnorePublicAcls))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketPublicAccessBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucketName := parseWebsiteId(d.Id(), "bucketName")
+	bucketLocation := parseWebsiteId(d.Id(), "bucketLocation")
+	instanceCRN := parseWebsiteId(d.Id(), "instanceCRN")
+	endpointType := parseWebsiteId(d.Id(), "endpointType")
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	deletePublicAccessBlockInput := &s3.DeletePublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	}
+	_, err = s3Client.DeletePublicAccessBlock(deletePublicAccessBlockInput)
+	if err != nil {
+		return diag.Errorf("failed to delete the public access block on the COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
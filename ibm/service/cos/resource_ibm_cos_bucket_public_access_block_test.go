@@ -0,0 +1,75 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Public_Access_Block_Basic(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-public-access-block%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_Public_Access_Block_Basic(serviceName, bucketName, bucketRegion, bucketClass, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access_block.block", "block_public_acls", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access_block.block", "ignore_public_acls", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_Public_Access_Block_Basic(serviceName, bucketName, bucketRegion, bucketClass, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access_block.block", "block_public_acls", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access_block.block", "ignore_public_acls", "false"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_public_access_block.block",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_Public_Access_Block_Basic(cosServiceName string, bucketName string, region string, storageClass string, ignorePublicAcls bool) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+	}
+
+	resource "ibm_cos_bucket_public_access_block" "block" {
+		bucket_crn         = ibm_cos_bucket.bucket.crn
+		bucket_location    = ibm_cos_bucket.bucket.region_location
+		block_public_acls  = true
+		ignore_public_acls = %t
+	}
+	`, cosServiceName, bucketName, region, storageClass, ignorePublicAcls)
+}
@@ -1368,7 +1368,7 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of ibm database (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -1806,8 +1806,8 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on update of Database (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on update of Database (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -264,7 +264,7 @@ func resourceIBMDb2InstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -978,7 +978,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource direct link gateway %s (%s) tags: %s", dtype, d.Id(), err)
 		}
 	}
@@ -1361,7 +1361,7 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource direct link gateway (%s) tags: %s", *instance.ID, err)
 		}
 	}
@@ -711,7 +711,7 @@ func resourceIBMdlGatewayCreateAction(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource direct link gateway %s tags: %s", d.Id(), err)
 		}
 	}
@@ -236,7 +236,7 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource direct link Provider gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -354,7 +354,7 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 		oldList, newList := d.GetChange(dlTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource direct link gateway dedicated (%s) tags: %s", *instance.ID, err)
 		}
 	}
@@ -437,8 +437,8 @@ func resourceIBMHPCSCreate(context context.Context, d *schema.ResourceData, meta
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of HPCS instance (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of HPCS instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -639,8 +639,8 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on update of HPCS instance (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on update of HPCS instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if update && !d.IsNewResource() { // Update RC API only if its not a new resource
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/importer"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// ImportListers returns the listers of the access groups of the account of
+// the provider, and of their dynamic rules and policies.
+func ImportListers() map[string]importer.Lister {
+	return map[string]importer.Lister{
+		"ibm_iam_access_group":              listIAMAccessGroupImports,
+		"ibm_iam_access_group_dynamic_rule": listIAMAccessGroupDynamicRuleImports,
+		"ibm_iam_access_group_policy":       listIAMAccessGroupPolicyImports,
+	}
+}
+
+// listIAMAccessGroups returns the access groups of the account, except the
+// public access group, which cannot be managed.
+func listIAMAccessGroups(meta interface{}) (string, []iamaccessgroupsv2.Group, error) {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return "", nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", nil, err
+	}
+	accountID := userDetails.UserAccount
+
+	offset := int64(0)
+	limit := int64(100)
+	listAccessGroupOption := iamAccessGroupsClient.NewListAccessGroupsOptions(accountID)
+	listAccessGroupOption.Limit = &limit
+	listAccessGroupOption.Offset = &offset
+	listAccessGroupOption.SetHidePublicAccess(true)
+	var allGroups []iamaccessgroupsv2.Group
+	for {
+		retreivedGroups, detailedResponse, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupOption)
+		if err != nil {
+			return "", nil, fmt.Errorf("[ERROR] Error retrieving access groups: %s. API Response is: %s", err, detailedResponse)
+		}
+		allGroups = append(allGroups, retreivedGroups.Groups...)
+		if len(retreivedGroups.Groups) == 0 || len(allGroups) >= flex.IntValue(retreivedGroups.TotalCount) {
+			break
+		}
+		offset = offset + limit
+		listAccessGroupOption.SetOffset(offset)
+	}
+	return accountID, allGroups, nil
+}
+
+func listIAMAccessGroupImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	_, groups, err := listIAMAccessGroups(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	for _, group := range groups {
+		items = append(items, importer.Item{ResourceType: "ibm_iam_access_group", ID: *group.ID, Name: *group.Name})
+	}
+	return items, nil
+}
+
+func listIAMAccessGroupDynamicRuleImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	_, groups, err := listIAMAccessGroups(meta)
+	if err != nil {
+		return nil, err
+	}
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	for _, group := range groups {
+		accessGroupRulesListOptions := iamAccessGroupsClient.NewListAccessGroupRulesOptions(*group.ID)
+		rules, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupRules(accessGroupRulesListOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving access group rules: %s. API Response: %s", err, detailedResponse)
+		}
+		for _, rule := range rules.Rules {
+			items = append(items, importer.Item{
+				ResourceType: "ibm_iam_access_group_dynamic_rule",
+				ID:           fmt.Sprintf("%s/%s", *group.ID, *rule.ID),
+				Name:         *rule.Name,
+			})
+		}
+	}
+	return items, nil
+}
+
+// listIAMAccessGroupPolicyImports lists the access policies of the access
+// groups, which are named after their group.
+func listIAMAccessGroupPolicyImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	accountID, groups, err := listIAMAccessGroups(meta)
+	if err != nil {
+		return nil, err
+	}
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	for _, group := range groups {
+		listPoliciesOptions := &iampolicymanagementv1.ListV2PoliciesOptions{
+			AccountID:     core.StringPtr(accountID),
+			AccessGroupID: group.ID,
+			Type:          core.StringPtr("access"),
+		}
+		policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing access group policies: %s, %s", err, resp)
+		}
+		for _, policy := range policyList.Policies {
+			items = append(items, importer.Item{
+				ResourceType: "ibm_iam_access_group_policy",
+				ID:           fmt.Sprintf("%s/%s", *group.ID, *policy.ID),
+				Name:         *group.Name,
+			})
+		}
+	}
+	return items, nil
+}
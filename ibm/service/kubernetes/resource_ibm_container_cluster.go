@@ -1182,7 +1182,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"An error occured during update of instance (%s) tags: %s", clusterID, err)
 		}
 
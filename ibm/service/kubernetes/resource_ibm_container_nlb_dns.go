@@ -22,6 +22,9 @@ func ResourceIBMContainerNlbDns() *schema.Resource {
 		ReadContext:   resourceIbmContainerNlbDnsRead,
 		UpdateContext: resourceIbmContainerNlbDnsUpdate,
 		DeleteContext: resourceIbmContainerNlbDnsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIbmContainerNlbDnsImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -139,35 +142,60 @@ func resourceIbmContainerNlbDnsRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Listing NLB DNS (%s): %s", d.Id(), err))
 	}
 
-	if nlbData != nil {
-		for _, nlbConfig := range nlbData {
-			if err = d.Set("cluster", d.Id()); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
-			}
-			if err = d.Set("nlb_dns_type", nlbConfig.Nlb.DnsType); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_dns_type: %s", err))
-			}
-			if err = d.Set("nlb_host", nlbConfig.Nlb.NlbSubdomain); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_host: %s", err))
-			}
-			if err = d.Set("nlb_ssl_secret_name", nlbConfig.SecretName); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_name: %s", err))
-			}
-			if err = d.Set("nlb_ssl_secret_status", nlbConfig.SecretStatus); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_status: %s", err))
-			}
-			if err = d.Set("nlb_type", nlbConfig.Nlb.Type); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_type: %s", err))
-			}
-			if err = d.Set("secret_namespace", nlbConfig.Nlb.SecretNamespace); err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_namespace: %s", err))
-			}
+	// A cluster has an NLB DNS per host, only the one of the resource is read
+	nlbHost := d.Get("nlb_host").(string)
+	found := false
+	for _, nlbConfig := range nlbData {
+		if nlbHost != "" && nlbConfig.Nlb.NlbSubdomain != nlbHost {
+			continue
+		}
+		found = true
+		if err = d.Set("cluster", d.Id()); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting cluster: %s", err))
+		}
+		if err = d.Set("nlb_dns_type", nlbConfig.Nlb.DnsType); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_dns_type: %s", err))
+		}
+		if err = d.Set("nlb_host", nlbConfig.Nlb.NlbSubdomain); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_host: %s", err))
+		}
+		if err = d.Set("nlb_ips", nlbConfig.Nlb.NlbIPArray); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ips: %s", err))
+		}
+		if err = d.Set("nlb_ssl_secret_name", nlbConfig.SecretName); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_name: %s", err))
+		}
+		if err = d.Set("nlb_ssl_secret_status", nlbConfig.SecretStatus); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_ssl_secret_status: %s", err))
+		}
+		if err = d.Set("nlb_type", nlbConfig.Nlb.Type); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting nlb_type: %s", err))
+		}
+		if err = d.Set("secret_namespace", nlbConfig.Nlb.SecretNamespace); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting secret_namespace: %s", err))
 		}
 	}
+	if !found && nlbHost != "" {
+		log.Printf("[WARN] NLB DNS (%s) of cluster %s not found, removing it from the state", nlbHost, d.Id())
+		d.SetId("")
+	}
 
 	return nil
 }
 
+// resourceIbmContainerNlbDnsImport imports the NLB DNS of a host of a
+// cluster, with the ID <cluster>/<nlb_host>.
+func resourceIbmContainerNlbDnsImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.ImportIdParts(d.Id(), "cluster", "nlb_host")
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(parts[0])
+	d.Set("cluster", parts[0])
+	d.Set("nlb_host", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIbmContainerNlbDnsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
@@ -213,7 +213,7 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(imagedata.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi capture (%s) pi_user_tags during creation: %s", *imagedata.ImageID, err)
+				flex.AddWarningf(d, "Error on update of pi capture (%s) pi_user_tags during creation: %s", *imagedata.ImageID, err)
 			}
 		}
 	}
@@ -306,7 +306,7 @@ func resourceIBMPICaptureUpdate(ctx context.Context, d *schema.ResourceData, met
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi capture (%s) pi_user_tags: %s", captureID, err)
+				flex.AddWarningf(d, "Error on update of pi capture (%s) pi_user_tags: %s", captureID, err)
 			}
 		}
 	}
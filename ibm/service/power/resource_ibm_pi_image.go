@@ -262,7 +262,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 				oldList, newList := d.GetChange(Arg_UserTags)
 				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(imageResponse.Crn), "", UserTagType)
 				if err != nil {
-					log.Printf("Error on update of pi image (%s) pi_user_tags during creation: %s", *IBMPIImageID, err)
+					flex.AddWarningf(d, "Error on update of pi image (%s) pi_user_tags during creation: %s", *IBMPIImageID, err)
 				}
 			}
 		}
@@ -357,7 +357,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 				oldList, newList := d.GetChange(Arg_UserTags)
 				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(image.Crn), "", UserTagType)
 				if err != nil {
-					log.Printf("Error on update of pi image (%s) pi_user_tags during creation: %s", *image.ImageID, err)
+					flex.AddWarningf(d, "Error on update of pi image (%s) pi_user_tags during creation: %s", *image.ImageID, err)
 				}
 			}
 		}
@@ -418,7 +418,7 @@ func resourceIBMPIImageUpdate(ctx context.Context, d *schema.ResourceData, meta
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi image (%s) pi_user_tags: %s", imageID, err)
+				flex.AddWarningf(d, "Error on update of pi image (%s) pi_user_tags: %s", imageID, err)
 			}
 		}
 	}
@@ -513,7 +513,7 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 			if s.Crn != "" {
 				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(s.Crn), "", UserTagType)
 				if err != nil {
-					log.Printf("Error on update of pi instance (%s) pi_user_tags during creation: %s", *s.PvmInstanceID, err)
+					flex.AddWarningf(d, "Error on update of pi instance (%s) pi_user_tags during creation: %s", *s.PvmInstanceID, err)
 				}
 			}
 		}
@@ -942,7 +942,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi instance (%s) pi_user_tags: %s", instanceID, err)
+				flex.AddWarningf(d, "Error on update of pi instance (%s) pi_user_tags: %s", instanceID, err)
 			}
 		}
 	}
@@ -5,13 +5,13 @@ package power
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,6 +23,9 @@ func ResourceIBMPIInstanceConsoleLanguage() *schema.Resource {
 		ReadContext:   resourceIBMPIInstanceConsoleLanguageRead,
 		UpdateContext: resourceIBMPIInstanceConsoleLanguageUpdate,
 		DeleteContext: resourceIBMPIInstanceConsoleLanguageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIInstanceConsoleLanguageImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -75,7 +78,7 @@ func resourceIBMPIInstanceConsoleLanguageCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, instanceName))
+	d.SetId(flex.CompositeId(cloudInstanceID, instanceName))
 
 	return resourceIBMPIInstanceConsoleLanguageRead(ctx, d, meta)
 }
@@ -85,6 +88,20 @@ func resourceIBMPIInstanceConsoleLanguageRead(ctx context.Context, d *schema.Res
 	return nil
 }
 
+// resourceIBMPIInstanceConsoleLanguageImport imports the console language of
+// an instance with the ID <pi_cloud_instance_id>/<pi_instance_name>. The
+// language cannot be read, so it is set by the first apply.
+func resourceIBMPIInstanceConsoleLanguageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.ImportIdParts(d.Id(), Arg_CloudInstanceID, Arg_InstanceName)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(flex.CompositeId(parts...))
+	d.Set(Arg_CloudInstanceID, parts[0])
+	d.Set(Arg_InstanceName, parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceIBMPIInstanceConsoleLanguageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -312,7 +312,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(networkResponse.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", networkID, err)
+				flex.AddWarningf(d, "Error on update of pi snapshot (%s) pi_user_tags during creation: %s", networkID, err)
 			}
 		}
 	}
@@ -423,7 +423,7 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network (%s) pi_user_tags: %s", networkID, err)
+				flex.AddWarningf(d, "Error on update of pi network (%s) pi_user_tags: %s", networkID, err)
 			}
 		}
 	}
@@ -122,7 +122,7 @@ func resourceIBMPINetworkAddressGroupCreate(ctx context.Context, d *schema.Resou
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*networkAddressGroup.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network address group (%s) pi_user_tags during creation: %s", *networkAddressGroup.ID, err)
+				flex.AddWarningf(d, "Error on update of pi network address group (%s) pi_user_tags during creation: %s", *networkAddressGroup.ID, err)
 			}
 		}
 	}
@@ -192,7 +192,7 @@ func resourceIBMPINetworkAddressGroupUpdate(ctx context.Context, d *schema.Resou
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network address group (%s) pi_user_tags: %s", parts[1], err)
+				flex.AddWarningf(d, "Error on update of pi network address group (%s) pi_user_tags: %s", parts[1], err)
 			}
 		}
 	}
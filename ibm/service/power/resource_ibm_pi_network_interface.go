@@ -177,7 +177,7 @@ func resourceIBMPINetworkInterfaceCreate(ctx context.Context, d *schema.Resource
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *crn, "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of network interface (%s) pi_user_tags: %s", networkInterfaceID, err)
+				flex.AddWarningf(d, "Error on update of network interface (%s) pi_user_tags: %s", networkInterfaceID, err)
 			}
 		}
 	}
@@ -259,7 +259,7 @@ func resourceIBMPINetworkInterfaceUpdate(ctx context.Context, d *schema.Resource
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of network interface (%s) pi_user_tags: %s", parts[2], err)
+				flex.AddWarningf(d, "Error on update of network interface (%s) pi_user_tags: %s", parts[2], err)
 			}
 		}
 	}
@@ -242,7 +242,7 @@ func resourceIBMPINetworkSecurityGroupCreate(ctx context.Context, d *schema.Reso
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(*networkSecurityGroup.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network security group (%s) pi_user_tags during creation: %s", *networkSecurityGroup.ID, err)
+				flex.AddWarningf(d, "Error on update of pi network security group (%s) pi_user_tags during creation: %s", *networkSecurityGroup.ID, err)
 			}
 		}
 	}
@@ -322,7 +322,7 @@ func resourceIBMPINetworkSecurityGroupUpdate(ctx context.Context, d *schema.Reso
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi network security group (%s) pi_user_tags: %s", nsgID, err)
+				flex.AddWarningf(d, "Error on update of pi network security group (%s) pi_user_tags: %s", nsgID, err)
 			}
 		}
 	}
@@ -237,7 +237,7 @@ func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.Resou
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(spp.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi shared processor pool (%s) pi_user_tags during creation: %s", *spp.ID, err)
+				flex.AddWarningf(d, "Error on update of pi shared processor pool (%s) pi_user_tags during creation: %s", *spp.ID, err)
 			}
 		}
 	}
@@ -398,7 +398,7 @@ func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.Resou
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi shared processor pool (%s) pi_user_tags: %s", sppID, err)
+				flex.AddWarningf(d, "Error on update of pi shared processor pool (%s) pi_user_tags: %s", sppID, err)
 			}
 		}
 	}
@@ -165,7 +165,7 @@ func resourceIBMPISnapshotCreate(ctx context.Context, d *schema.ResourceData, me
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(snapshotResponse.Crn), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err)
+				flex.AddWarningf(d, "Error on update of pi snapshot (%s) pi_user_tags during creation: %s", *snapshotResponse.SnapshotID, err)
 			}
 		}
 	}
@@ -244,7 +244,7 @@ func resourceIBMPISnapshotUpdate(ctx context.Context, d *schema.ResourceData, me
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err)
+				flex.AddWarningf(d, "Error on update of pi snapshot (%s) pi_user_tags: %s", snapshotID, err)
 			}
 		}
 	}
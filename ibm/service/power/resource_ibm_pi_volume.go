@@ -327,7 +327,7 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		oldList, newList := d.GetChange(Arg_UserTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(vol.Crn), "", UserTagType)
 		if err != nil {
-			log.Printf("Error on update of volume (%s) pi_user_tags during creation: %s", volumeid, err)
+			flex.AddWarningf(d, "Error on update of volume (%s) pi_user_tags during creation: %s", volumeid, err)
 		}
 	}
 
@@ -449,7 +449,7 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of pi volume (%s) pi_user_tags: %s", volumeID, err)
+				flex.AddWarningf(d, "Error on update of pi volume (%s) pi_user_tags: %s", volumeID, err)
 			}
 		}
 	}
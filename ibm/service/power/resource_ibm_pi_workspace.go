@@ -125,7 +125,7 @@ func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, m
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *controller.CRN, "", UserTagType)
 			if err != nil {
-				log.Printf("Error on creation of workspace (%s) pi_user_tags: %s", *controller.CRN, err)
+				flex.AddWarningf(d, "Error on creation of workspace (%s) pi_user_tags: %s", *controller.CRN, err)
 			}
 		}
 	}
@@ -248,7 +248,7 @@ func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, m
 			oldList, newList := d.GetChange(Arg_UserTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
 			if err != nil {
-				log.Printf("Error on update of workspace (%s) pi_user_tags: %s", crn, err)
+				flex.AddWarningf(d, "Error on update of workspace (%s) pi_user_tags: %s", crn, err)
 			}
 		}
 	}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/importer"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// ImportListers returns the listers of the resource instances of the account
+// of the provider, which can be filtered by the resource_group_id option.
+func ImportListers() map[string]importer.Lister {
+	return map[string]importer.Lister{
+		"ibm_resource_instance": listResourceInstanceImports,
+	}
+}
+
+func listResourceInstanceImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	resourceInstanceListOptions := rc.ListResourceInstancesOptions{}
+	if rg := options["resource_group_id"]; rg != "" {
+		resourceInstanceListOptions.ResourceGroupID = &rg
+	}
+
+	var items []importer.Item
+	next_url := ""
+	for {
+		if next_url != "" {
+			resourceInstanceListOptions.Start = &next_url
+		}
+		listInstanceResponse, resp, err := rsConClient.ListResourceInstances(&resourceInstanceListOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving resource instances: %s with resp code: %s", err, resp)
+		}
+		for _, instance := range listInstanceResponse.Resources {
+			items = append(items, importer.Item{ResourceType: "ibm_resource_instance", ID: *instance.ID, Name: *instance.Name})
+		}
+		next_url, err = getInstancesNext(listInstanceResponse.NextURL)
+		if err != nil {
+			return nil, fmt.Errorf("[DEBUG] ListResourceInstances failed. Error occurred while parsing NextURL: %s", err)
+		}
+		if next_url == "" {
+			break
+		}
+	}
+	return items, nil
+}
@@ -534,7 +534,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -775,7 +775,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
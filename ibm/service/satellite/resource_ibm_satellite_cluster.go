@@ -506,7 +506,7 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *cluster.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of ibm satellite location (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -734,7 +734,7 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *cluster.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"An error occured during update of instance (%s) tags: %s", clusterID, err)
 		}
 	}
@@ -355,7 +355,7 @@ func resourceIBMSatelliteLocationCreate(d *schema.ResourceData, meta interface{}
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of ibm satellite location (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -463,7 +463,7 @@ func resourceIBMSatelliteLocationUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"An error occured during update of instance (%s) tags: %s", ID, err)
 		}
 	}
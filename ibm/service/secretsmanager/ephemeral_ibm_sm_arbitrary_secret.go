@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// NewSmArbitrarySecretEphemeralResource returns the ephemeral variant of the
+// ibm_sm_arbitrary_secret data source.
+func NewSmArbitrarySecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    ArbitrarySecretResourceName,
+		secretType:  ArbitrarySecretType,
+		description: "Reads the payload of an arbitrary secret, without persisting it in the plan or the state.",
+		attributes: map[string]ephemeralschema.Attribute{
+			"payload": smSensitiveString("The arbitrary secret's data payload."),
+		},
+		values: func(secret secretsmanagerv2.SecretIntf) (map[string]attr.Value, error) {
+			arbitrarySecret, ok := secret.(*secretsmanagerv2.ArbitrarySecret)
+			if !ok {
+				return nil, smUnexpectedSecretType(secret)
+			}
+			values := smSecretValues(arbitrarySecret.ID, arbitrarySecret.Name, arbitrarySecret.SecretGroupID, arbitrarySecret.Crn)
+			values["payload"] = types.StringPointerValue(arbitrarySecret.Payload)
+			return values, nil
+		},
+	}
+}
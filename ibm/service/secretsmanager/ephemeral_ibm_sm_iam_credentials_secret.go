@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// NewSmIamCredentialsSecretEphemeralResource returns the ephemeral variant of
+// the ibm_sm_iam_credentials_secret data source.
+func NewSmIamCredentialsSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    IAMCredentialsSecretResourceName,
+		secretType:  IAMCredentialsSecretType,
+		description: "Reads the API key of an IAM credentials secret, without persisting it in the plan or the state.",
+		attributes: map[string]ephemeralschema.Attribute{
+			"api_key": smSensitiveString("The API key that is generated for this secret."),
+			"api_key_id": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the API key that is generated for this secret.",
+			},
+			"service_id": ephemeralschema.StringAttribute{
+				Computed:    true,
+				Description: "The service ID under which the API key is created.",
+			},
+		},
+		values: func(secret secretsmanagerv2.SecretIntf) (map[string]attr.Value, error) {
+			iAMCredentialsSecret, ok := secret.(*secretsmanagerv2.IAMCredentialsSecret)
+			if !ok {
+				return nil, smUnexpectedSecretType(secret)
+			}
+			values := smSecretValues(iAMCredentialsSecret.ID, iAMCredentialsSecret.Name, iAMCredentialsSecret.SecretGroupID, iAMCredentialsSecret.Crn)
+			values["api_key"] = types.StringPointerValue(iAMCredentialsSecret.ApiKey)
+			values["api_key_id"] = types.StringPointerValue(iAMCredentialsSecret.ApiKeyID)
+			values["service_id"] = types.StringPointerValue(iAMCredentialsSecret.ServiceID)
+			return values, nil
+		},
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// NewSmImportedCertificateEphemeralResource returns the ephemeral variant of
+// the ibm_sm_imported_certificate data source.
+func NewSmImportedCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    ImportedCertSecretResourceName,
+		secretType:  ImportedCertSecretType,
+		description: "Reads the certificate and private key of an imported certificate, without persisting them in the plan or the state.",
+		attributes: map[string]ephemeralschema.Attribute{
+			"certificate":  smSensitiveString("The PEM-encoded contents of your certificate."),
+			"intermediate": smSensitiveString("(Optional) The PEM-encoded intermediate certificate to associate with the root certificate."),
+			"private_key":  smSensitiveString("(Optional) The PEM-encoded private key to associate with the certificate."),
+		},
+		values: func(secret secretsmanagerv2.SecretIntf) (map[string]attr.Value, error) {
+			importedCertificate, ok := secret.(*secretsmanagerv2.ImportedCertificate)
+			if !ok {
+				return nil, smUnexpectedSecretType(secret)
+			}
+			values := smSecretValues(importedCertificate.ID, importedCertificate.Name, importedCertificate.SecretGroupID, importedCertificate.Crn)
+			values["certificate"] = types.StringPointerValue(importedCertificate.Certificate)
+			values["intermediate"] = types.StringPointerValue(importedCertificate.Intermediate)
+			values["private_key"] = types.StringPointerValue(importedCertificate.PrivateKey)
+			return values, nil
+		},
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// NewSmKvSecretEphemeralResource returns the ephemeral variant of the
+// ibm_sm_kv_secret data source.
+func NewSmKvSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    KvSecretResourceName,
+		secretType:  KvSecretType,
+		description: "Reads the data of a key-value secret, without persisting it in the plan or the state.",
+		attributes: map[string]ephemeralschema.Attribute{
+			"data": ephemeralschema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload data of a key-value secret.",
+			},
+		},
+		values: func(secret secretsmanagerv2.SecretIntf) (map[string]attr.Value, error) {
+			kVSecret, ok := secret.(*secretsmanagerv2.KVSecret)
+			if !ok {
+				return nil, smUnexpectedSecretType(secret)
+			}
+			values := smSecretValues(kVSecret.ID, kVSecret.Name, kVSecret.SecretGroupID, kVSecret.Crn)
+			data := make(map[string]attr.Value, len(kVSecret.Data))
+			for k, v := range flex.Flatten(kVSecret.Data) {
+				data[k] = types.StringValue(v)
+			}
+			values["data"] = types.MapValueMust(types.StringType, data)
+			return values, nil
+		},
+	}
+}
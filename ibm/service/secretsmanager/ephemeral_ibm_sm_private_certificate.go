@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// NewSmPrivateCertificateEphemeralResource returns the ephemeral variant of
+// the ibm_sm_private_certificate data source.
+func NewSmPrivateCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    PrivateCertSecretResourceName,
+		secretType:  PrivateCertSecretType,
+		description: "Reads the certificate and private key of a private certificate, without persisting them in the plan or the state.",
+		attributes: map[string]ephemeralschema.Attribute{
+			"certificate": smSensitiveString("The PEM-encoded contents of your certificate."),
+			"private_key": smSensitiveString("(Optional) The PEM-encoded private key to associate with the certificate."),
+			"issuing_ca":  smSensitiveString("The PEM-encoded certificate of the certificate authority that signed and issued this certificate."),
+			"ca_chain": ephemeralschema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The chain of certificate authorities that are associated with the certificate.",
+			},
+		},
+		values: func(secret secretsmanagerv2.SecretIntf) (map[string]attr.Value, error) {
+			privateCertificate, ok := secret.(*secretsmanagerv2.PrivateCertificate)
+			if !ok {
+				return nil, smUnexpectedSecretType(secret)
+			}
+			values := smSecretValues(privateCertificate.ID, privateCertificate.Name, privateCertificate.SecretGroupID, privateCertificate.Crn)
+			values["certificate"] = types.StringPointerValue(privateCertificate.Certificate)
+			values["private_key"] = types.StringPointerValue(privateCertificate.PrivateKey)
+			values["issuing_ca"] = types.StringPointerValue(privateCertificate.IssuingCa)
+			caChain := make([]attr.Value, 0, len(privateCertificate.CaChain))
+			for _, ca := range privateCertificate.CaChain {
+				caChain = append(caChain, types.StringValue(ca))
+			}
+			values["ca_chain"] = types.ListValueMust(types.StringType, caChain)
+			return values, nil
+		},
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// smSecretEphemeralResource is an ephemeral resource reading the value of a
+// secret, which Terraform does not persist in the plan or the state, unlike
+// the one of the matching data source.
+type smSecretEphemeralResource struct {
+	meta interface{}
+
+	typeName    string
+	secretType  string
+	description string
+	// The computed attributes holding the value of the secret
+	attributes map[string]ephemeralschema.Attribute
+	// values returns the values of the attributes, from the secret
+	values func(secret secretsmanagerv2.SecretIntf) (map[string]attr.Value, error)
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &smSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smSecretEphemeralResource{}
+)
+
+func (r *smSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *smSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]ephemeralschema.Attribute{
+		"instance_id": ephemeralschema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the Secrets Manager instance.",
+		},
+		"region": ephemeralschema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The region of the Secrets Manager instance.",
+		},
+		"endpoint_type": ephemeralschema.StringAttribute{
+			Optional:    true,
+			Description: "public or private.",
+		},
+		"secret_id": ephemeralschema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the secret.",
+		},
+		"name": ephemeralschema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The human-readable name of your secret.",
+		},
+		"secret_group_name": ephemeralschema.StringAttribute{
+			Optional:    true,
+			Description: "The human-readable name of your secret group.",
+		},
+		"secret_group_id": ephemeralschema.StringAttribute{
+			Computed:    true,
+			Description: "A v4 UUID identifier, or `default` secret group.",
+		},
+		"crn": ephemeralschema.StringAttribute{
+			Computed:    true,
+			Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+		},
+	}
+	for name, attribute := range r.attributes {
+		attributes[name] = attribute
+	}
+	resp.Schema = ephemeralschema.Schema{
+		Description: r.description,
+		Attributes:  attributes,
+	}
+}
+
+func (r *smSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.meta = req.ProviderData
+}
+
+func (r *smSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var secretId, name, groupName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	if resp.Diagnostics.HasError() || secretId.IsUnknown() || name.IsUnknown() || groupName.IsUnknown() {
+		return
+	}
+
+	if secretId.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddError("Invalid secret reference", "Exactly one of \"secret_id\" or \"name\" must be specified")
+	}
+	if name.IsNull() != groupName.IsNull() {
+		resp.Diagnostics.AddError("Invalid secret reference", "\"name\" and \"secret_group_name\" must be specified together")
+	}
+}
+
+func (r *smSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var instanceId, region, endpointType, secretId, name, groupName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("instance_id"), &instanceId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("endpoint_type"), &endpointType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_id"), &secretId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_group_name"), &groupName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, ok := r.meta.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError("Provider not configured", fmt.Sprintf("The provider must be configured to open %s", r.typeName))
+		return
+	}
+	secretsManagerClient, err := session.SecretsManagerV2()
+	if err != nil {
+		r.addError(resp, err, "")
+		return
+	}
+	if region.ValueString() == "" {
+		region = types.StringValue(getDefaultRegion(secretsManagerClient))
+	}
+	if endpointType.ValueString() == "" {
+		endpointType = types.StringValue(getDefaultEndpointType(secretsManagerClient))
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId.ValueString(), region.ValueString(), endpointType.ValueString())
+
+	log.Printf("[DEBUG] Opening %s %q %q %q\n", r.typeName, secretId.ValueString(), name.ValueString(), groupName.ValueString())
+	var secret secretsmanagerv2.SecretIntf
+	var response *core.DetailedResponse
+	if secretId.ValueString() != "" {
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+		getSecretOptions.SetID(secretId.ValueString())
+
+		secret, response, err = secretsManagerClient.GetSecretWithContext(ctx, getSecretOptions)
+		if err != nil {
+			r.addError(resp, err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response))
+			return
+		}
+	} else {
+		getSecretByNameOptions := &secretsmanagerv2.GetSecretByNameTypeOptions{}
+		getSecretByNameOptions.SetName(name.ValueString())
+		getSecretByNameOptions.SetSecretType(r.secretType)
+		getSecretByNameOptions.SetSecretGroupName(groupName.ValueString())
+
+		secret, response, err = secretsManagerClient.GetSecretByNameTypeWithContext(ctx, getSecretByNameOptions)
+		if err != nil {
+			r.addError(resp, err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response))
+			return
+		}
+	}
+
+	values, err := r.values(secret)
+	if err != nil {
+		r.addError(resp, err, fmt.Sprintf("Error reading the secret of type %s", r.secretType))
+		return
+	}
+	values["region"] = region
+	for attribute, value := range values {
+		resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+}
+
+// addError reports err like the data sources do, as a Terraform problem.
+func (r *smSecretEphemeralResource) addError(resp *ephemeral.OpenResponse, err error, summary string) {
+	tfErr := flex.TerraformErrorf(err, summary, fmt.Sprintf("(Ephemeral) %s", r.typeName), "open")
+	for _, d := range tfErr.GetDiag() {
+		resp.Diagnostics.AddError(d.Summary, d.Detail)
+	}
+}
+
+// smSecretValues returns the values of the attributes common to all the
+// secrets.
+func smSecretValues(id, name, secretGroupID, crn *string) map[string]attr.Value {
+	return map[string]attr.Value{
+		"secret_id":       types.StringPointerValue(id),
+		"name":            types.StringPointerValue(name),
+		"secret_group_id": types.StringPointerValue(secretGroupID),
+		"crn":             types.StringPointerValue(crn),
+	}
+}
+
+// smSensitiveString returns a computed sensitive string attribute holding
+// a value of the secret.
+func smSensitiveString(description string) ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		Computed:    true,
+		Sensitive:   true,
+		Description: description,
+	}
+}
+
+// smUnexpectedSecretType returns the error of a secret of another type than
+// the one expected by the ephemeral resource.
+func smUnexpectedSecretType(secret secretsmanagerv2.SecretIntf) error {
+	return fmt.Errorf("Unexpected secret %T", secret)
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// NewSmUsernamePasswordSecretEphemeralResource returns the ephemeral variant
+// of the ibm_sm_username_password_secret data source.
+func NewSmUsernamePasswordSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smSecretEphemeralResource{
+		typeName:    UsernamePasswordSecretResourceName,
+		secretType:  UsernamePasswordSecretType,
+		description: "Reads the credentials of a user credentials secret, without persisting them in the plan or the state.",
+		attributes: map[string]ephemeralschema.Attribute{
+			"username": smSensitiveString("The username that is assigned to the secret."),
+			"password": smSensitiveString("The password that is assigned to the secret."),
+		},
+		values: func(secret secretsmanagerv2.SecretIntf) (map[string]attr.Value, error) {
+			usernamePasswordSecret, ok := secret.(*secretsmanagerv2.UsernamePasswordSecret)
+			if !ok {
+				return nil, smUnexpectedSecretType(secret)
+			}
+			values := smSecretValues(usernamePasswordSecret.ID, usernamePasswordSecret.Name, usernamePasswordSecret.SecretGroupID, usernamePasswordSecret.Crn)
+			values["username"] = types.StringPointerValue(usernamePasswordSecret.Username)
+			values["password"] = types.StringPointerValue(usernamePasswordSecret.Password)
+			return values, nil
+		},
+	}
+}
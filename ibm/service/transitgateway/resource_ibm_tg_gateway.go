@@ -226,7 +226,7 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of transit gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -356,7 +356,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 		oldList, newList := d.GetChange(tgGatewayTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of transit gateway (%s) tags: %s", ID, err)
 		}
 	}
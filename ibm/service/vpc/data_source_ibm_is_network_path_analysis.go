@@ -0,0 +1,537 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/netpath"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isNetworkPathSource                  = "source"
+	isNetworkPathDestination             = "destination"
+	isNetworkPathInstance                = "instance"
+	isNetworkPathVirtualNetworkInterface = "virtual_network_interface"
+	isNetworkPathReservedIP              = "reserved_ip"
+	isNetworkPathCIDR                    = "cidr"
+	isNetworkPathProtocol                = "protocol"
+	isNetworkPathPort                    = "port"
+	isNetworkPathSourcePort              = "source_port"
+	isNetworkPathICMPType                = "icmp_type"
+	isNetworkPathICMPCode                = "icmp_code"
+	isNetworkPathAllowed                 = "allowed"
+	isNetworkPathHops                    = "hops"
+)
+
+// DataSourceIBMIsNetworkPathAnalysis evaluates offline whether the security
+// groups, network ACLs and routing tables of a VPC allow a flow from a source
+// to a destination.
+func DataSourceIBMIsNetworkPathAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsNetworkPathAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			isNetworkPathSource: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The source of the traffic.",
+				Elem: &schema.Resource{
+					Schema: dataSourceIBMIsNetworkPathEndpointSchema(isNetworkPathSource),
+				},
+			},
+			isNetworkPathDestination: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The destination of the traffic.",
+				Elem: &schema.Resource{
+					Schema: dataSourceIBMIsNetworkPathEndpointSchema(isNetworkPathDestination),
+				},
+			},
+			isNetworkPathProtocol: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{netpath.ProtocolAll, netpath.ProtocolICMP, netpath.ProtocolTCP, netpath.ProtocolUDP}),
+				Description:  "The protocol of the traffic: all, icmp, tcp or udp.",
+			},
+			isNetworkPathPort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.ValidatePortRange(1, 65535),
+				Description:  "The destination port of tcp and udp traffic. When unset, the traffic is allowed only by the rules allowing all the ports.",
+			},
+			isNetworkPathSourcePort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.ValidatePortRange(1, 65535),
+				Description:  "The source port of tcp and udp traffic. When unset, it is any of the ephemeral ports, from 1024 to 65535, and the response of the traffic goes back to them.",
+			},
+			isNetworkPathICMPType: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.ValidatePortRange(0, 254),
+				Description:  "The ICMP type of icmp traffic.",
+			},
+			isNetworkPathICMPCode: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validate.ValidatePortRange(0, 255),
+				Description:  "The ICMP code of icmp traffic.",
+			},
+			isNetworkPathAllowed: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether every hop allows the traffic.",
+			},
+			isNetworkPathHops: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The security groups, network ACLs and routing table evaluated, in the order the traffic and its response cross them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hop: source_security_groups, source_network_acl, source_routing_table, destination_network_acl, destination_security_groups, destination_network_acl_response or source_network_acl_response.",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the network ACL or routing table, or of the security group whose rule allows the traffic. When the security groups deny the traffic, the comma-separated IDs of all of them.",
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the hop allows the traffic.",
+						},
+						"rule_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule or route matching the traffic. Empty when no rule allows the traffic.",
+						},
+						"rule_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the network ACL rule or route matching the traffic.",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the hop allows or denies the traffic.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsNetworkPathEndpointSchema(endpoint string) map[string]*schema.Schema {
+	kinds := []string{
+		endpoint + ".0." + isNetworkPathInstance,
+		endpoint + ".0." + isNetworkPathVirtualNetworkInterface,
+		endpoint + ".0." + isNetworkPathReservedIP,
+		endpoint + ".0." + isNetworkPathCIDR,
+	}
+	return map[string]*schema.Schema{
+		isNetworkPathInstance: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: kinds,
+			Description:  "The ID of an instance, whose primary network interface or attachment is used.",
+		},
+		isNetworkPathVirtualNetworkInterface: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: kinds,
+			Description:  "The ID of a virtual network interface.",
+		},
+		isNetworkPathReservedIP: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: kinds,
+			Description:  "The reserved IP, as <subnet_id>/<reserved_ip_id>.",
+		},
+		isNetworkPathCIDR: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: kinds,
+			ValidateFunc: validate.ValidateCIDR,
+			Description:  "A CIDR block. Within the VPC of the other endpoint, the network ACL and routing table of its subnet are evaluated.",
+		},
+	}
+}
+
+func dataSourceIBMIsNetworkPathAnalysisRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	loader := &networkPathLoader{
+		context:        context,
+		sess:           sess,
+		subnets:        map[string]*netpath.Subnet{},
+		subnetVPCs:     map[string]string{},
+		networkACLs:    map[string]*netpath.NetworkACL{},
+		routingTables:  map[string]*netpath.RoutingTable{},
+		securityGroups: map[string]netpath.SecurityGroup{},
+	}
+
+	source, sourceVPC, err := loader.endpoint(d.Get(isNetworkPathSource).([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	destination, destinationVPC, err := loader.endpoint(d.Get(isNetworkPathDestination).([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if sourceVPC == "" && destinationVPC == "" {
+		return diag.FromErr(fmt.Errorf("[ERROR] At least one of source and destination must be an instance, a virtual network interface or a reserved IP"))
+	}
+	if sourceVPC == "" {
+		err = loader.cidrSubnet(&source, destinationVPC)
+	} else if destinationVPC == "" {
+		err = loader.cidrSubnet(&destination, sourceVPC)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flow := netpath.Flow{
+		Protocol: d.Get(isNetworkPathProtocol).(string),
+	}
+	if port, ok := d.GetOk(isNetworkPathPort); ok {
+		flow.DestinationPorts = netpath.PortRange{Min: port.(int), Max: port.(int)}
+	}
+	if port, ok := d.GetOk(isNetworkPathSourcePort); ok {
+		flow.SourcePorts = netpath.PortRange{Min: port.(int), Max: port.(int)}
+	}
+	// Using GetOkExists to detect 0 as the possible values.
+	if icmpType, ok := d.GetOkExists(isNetworkPathICMPType); ok {
+		t := icmpType.(int)
+		flow.ICMPType = &t
+	}
+	if icmpCode, ok := d.GetOkExists(isNetworkPathICMPCode); ok {
+		c := icmpCode.(int)
+		flow.ICMPCode = &c
+	}
+
+	result := netpath.Evaluate(source, destination, flow)
+	hops := make([]map[string]interface{}, 0, len(result.Hops))
+	for _, hop := range result.Hops {
+		hops = append(hops, map[string]interface{}{
+			"name":        hop.Name,
+			"resource_id": hop.ResourceID,
+			"allowed":     hop.Allowed,
+			"rule_id":     hop.RuleID,
+			"rule_name":   hop.RuleName,
+			"reason":      hop.Reason,
+		})
+	}
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set(isNetworkPathAllowed, result.Allowed); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting allowed: %s", err))
+	}
+	if err = d.Set(isNetworkPathHops, hops); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting hops: %s", err))
+	}
+	return nil
+}
+
+// networkPathLoader fetches the subnets, network ACLs, routing tables and
+// security groups of the endpoints, each once.
+type networkPathLoader struct {
+	context        context.Context
+	sess           *vpcv1.VpcV1
+	subnets        map[string]*netpath.Subnet
+	subnetVPCs     map[string]string
+	networkACLs    map[string]*netpath.NetworkACL
+	routingTables  map[string]*netpath.RoutingTable
+	securityGroups map[string]netpath.SecurityGroup
+}
+
+// endpoint returns the endpoint of a source or destination block, and the ID
+// of its VPC, which is empty for a CIDR block.
+func (l *networkPathLoader) endpoint(block map[string]interface{}) (netpath.Endpoint, string, error) {
+	if instanceID := block[isNetworkPathInstance].(string); instanceID != "" {
+		instance, response, err := l.sess.GetInstanceWithContext(l.context, l.sess.NewGetInstanceOptions(instanceID))
+		if err != nil {
+			return netpath.Endpoint{}, "", fmt.Errorf("[ERROR] Error getting instance (%s): %s\n%s", instanceID, err, response)
+		}
+		if instance.PrimaryNetworkAttachment != nil && instance.PrimaryNetworkAttachment.VirtualNetworkInterface != nil {
+			return l.virtualNetworkInterfaceEndpoint(*instance.PrimaryNetworkAttachment.VirtualNetworkInterface.ID)
+		}
+		return l.networkInterfaceEndpoint(instanceID, *instance.PrimaryNetworkInterface.ID)
+	}
+	if vniID := block[isNetworkPathVirtualNetworkInterface].(string); vniID != "" {
+		return l.virtualNetworkInterfaceEndpoint(vniID)
+	}
+	if reservedIP := block[isNetworkPathReservedIP].(string); reservedIP != "" {
+		return l.reservedIPEndpoint(reservedIP)
+	}
+	prefix, err := netip.ParsePrefix(block[isNetworkPathCIDR].(string))
+	if err != nil {
+		return netpath.Endpoint{}, "", fmt.Errorf("[ERROR] Error parsing CIDR block: %s", err)
+	}
+	return netpath.Endpoint{Prefix: prefix.Masked()}, "", nil
+}
+
+func (l *networkPathLoader) virtualNetworkInterfaceEndpoint(id string) (netpath.Endpoint, string, error) {
+	vni, response, err := l.sess.GetVirtualNetworkInterfaceWithContext(l.context, l.sess.NewGetVirtualNetworkInterfaceOptions(id))
+	if err != nil {
+		return netpath.Endpoint{}, "", fmt.Errorf("[ERROR] Error getting virtual network interface (%s): %s\n%s", id, err, response)
+	}
+	return l.addressEndpoint(*vni.PrimaryIP.Address, *vni.Subnet.ID, vni.SecurityGroups)
+}
+
+func (l *networkPathLoader) networkInterfaceEndpoint(instanceID, id string) (netpath.Endpoint, string, error) {
+	nic, response, err := l.sess.GetInstanceNetworkInterfaceWithContext(l.context, l.sess.NewGetInstanceNetworkInterfaceOptions(instanceID, id))
+	if err != nil {
+		return netpath.Endpoint{}, "", fmt.Errorf("[ERROR] Error getting network interface (%s) of instance (%s): %s\n%s", id, instanceID, err, response)
+	}
+	return l.addressEndpoint(*nic.PrimaryIP.Address, *nic.Subnet.ID, nic.SecurityGroups)
+}
+
+// reservedIPEndpoint returns the endpoint of a reserved IP, with the security
+// groups of the virtual network interface or instance network interface it is
+// bound to.
+func (l *networkPathLoader) reservedIPEndpoint(id string) (netpath.Endpoint, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return netpath.Endpoint{}, "", fmt.Errorf("[ERROR] Incorrect reserved IP %s: it should be a combination of subnetID/reservedIPID", id)
+	}
+	reservedIP, response, err := l.sess.GetSubnetReservedIPWithContext(l.context, l.sess.NewGetSubnetReservedIPOptions(parts[0], parts[1]))
+	if err != nil {
+		return netpath.Endpoint{}, "", fmt.Errorf("[ERROR] Error getting reserved IP (%s): %s\n%s", id, err, response)
+	}
+	if target, ok := reservedIP.Target.(*vpcv1.ReservedIPTarget); ok && target != nil && target.ResourceType != nil && target.ID != nil {
+		switch *target.ResourceType {
+		case "virtual_network_interface":
+			return l.virtualNetworkInterfaceEndpoint(*target.ID)
+		case "network_interface":
+			// The href of an instance network interface is
+			// .../instances/<instance_id>/network_interfaces/<id>
+			path := strings.Split(*target.Href, "/")
+			if len(path) >= 4 && path[len(path)-4] == "instances" {
+				return l.networkInterfaceEndpoint(path[len(path)-3], *target.ID)
+			}
+		}
+	}
+	return l.addressEndpoint(*reservedIP.Address, parts[0], nil)
+}
+
+func (l *networkPathLoader) addressEndpoint(address, subnetID string, groups []vpcv1.SecurityGroupReference) (netpath.Endpoint, string, error) {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return netpath.Endpoint{}, "", fmt.Errorf("[ERROR] Error parsing address %s: %s", address, err)
+	}
+	endpoint := netpath.Endpoint{
+		Prefix: netip.PrefixFrom(addr, addr.BitLen()),
+	}
+	var vpcID string
+	endpoint.Subnet, vpcID, err = l.subnet(subnetID)
+	if err != nil {
+		return netpath.Endpoint{}, "", err
+	}
+	for _, group := range groups {
+		securityGroup, err := l.securityGroup(*group.ID)
+		if err != nil {
+			return netpath.Endpoint{}, "", err
+		}
+		endpoint.SecurityGroups = append(endpoint.SecurityGroups, securityGroup)
+	}
+	return endpoint, vpcID, nil
+}
+
+// cidrSubnet sets the subnet of a CIDR block endpoint to the subnet of the VPC
+// containing it, if any.
+func (l *networkPathLoader) cidrSubnet(endpoint *netpath.Endpoint, vpcID string) error {
+	start := ""
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnets, response, err := l.sess.ListSubnetsWithContext(l.context, listSubnetsOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing subnets of VPC (%s): %s\n%s", vpcID, err, response)
+		}
+		for _, subnet := range subnets.Subnets {
+			if subnet.Ipv4CIDRBlock == nil {
+				continue
+			}
+			cidr, err := netip.ParsePrefix(*subnet.Ipv4CIDRBlock)
+			if err != nil || cidr.Bits() > endpoint.Prefix.Bits() || !cidr.Contains(endpoint.Prefix.Addr()) {
+				continue
+			}
+			endpoint.Subnet, _, err = l.subnet(*subnet.ID)
+			return err
+		}
+		start = flex.GetNext(subnets.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+func (l *networkPathLoader) subnet(id string) (*netpath.Subnet, string, error) {
+	if subnet, ok := l.subnets[id]; ok {
+		return subnet, l.subnetVPCs[id], nil
+	}
+	subnet, response, err := l.sess.GetSubnetWithContext(l.context, l.sess.NewGetSubnetOptions(id))
+	if err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error getting subnet (%s): %s\n%s", id, err, response)
+	}
+	s := &netpath.Subnet{
+		ID:   id,
+		Name: *subnet.Name,
+		Zone: *subnet.Zone.Name,
+	}
+	if subnet.NetworkACL != nil {
+		if s.NetworkACL, err = l.networkACL(*subnet.NetworkACL.ID); err != nil {
+			return nil, "", err
+		}
+	}
+	if subnet.RoutingTable != nil {
+		if s.RoutingTable, err = l.routingTable(*subnet.VPC.ID, *subnet.RoutingTable.ID); err != nil {
+			return nil, "", err
+		}
+	}
+	l.subnets[id], l.subnetVPCs[id] = s, *subnet.VPC.ID
+	return s, *subnet.VPC.ID, nil
+}
+
+func (l *networkPathLoader) networkACL(id string) (*netpath.NetworkACL, error) {
+	if acl, ok := l.networkACLs[id]; ok {
+		return acl, nil
+	}
+	nwacl, response, err := l.sess.GetNetworkACLWithContext(l.context, &vpcv1.GetNetworkACLOptions{ID: &id})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting Network ACL(%s) : %s\n%s", id, err, response)
+	}
+	acl := &netpath.NetworkACL{
+		ID:   id,
+		Name: *nwacl.Name,
+	}
+	for _, rulex := range nwacl.Rules {
+		var rule netpath.NetworkACLRule
+		switch rulex := rulex.(type) {
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+			rule = netpath.NetworkACLRule{ID: *rulex.ID, Name: *rulex.Name, Action: *rulex.Action, Direction: *rulex.Direction,
+				Protocol: *rulex.Protocol, Source: *rulex.Source, Destination: *rulex.Destination}
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+			rule = netpath.NetworkACLRule{ID: *rulex.ID, Name: *rulex.Name, Action: *rulex.Action, Direction: *rulex.Direction,
+				Protocol: *rulex.Protocol, Source: *rulex.Source, Destination: *rulex.Destination,
+				ICMPType: int64PtrToIntPtr(rulex.Type), ICMPCode: int64PtrToIntPtr(rulex.Code)}
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+			rule = netpath.NetworkACLRule{ID: *rulex.ID, Name: *rulex.Name, Action: *rulex.Action, Direction: *rulex.Direction,
+				Protocol: *rulex.Protocol, Source: *rulex.Source, Destination: *rulex.Destination,
+				DestinationPorts: netpath.PortRange{Min: checkNetworkACLNil(rulex.DestinationPortMin), Max: checkNetworkACLNil(rulex.DestinationPortMax)},
+				SourcePorts:      netpath.PortRange{Min: checkNetworkACLNil(rulex.SourcePortMin), Max: checkNetworkACLNil(rulex.SourcePortMax)}}
+		default:
+			continue
+		}
+		acl.Rules = append(acl.Rules, rule)
+	}
+	l.networkACLs[id] = acl
+	return acl, nil
+}
+
+func (l *networkPathLoader) routingTable(vpcID, id string) (*netpath.RoutingTable, error) {
+	if table, ok := l.routingTables[id]; ok {
+		return table, nil
+	}
+	routingTable, response, err := l.sess.GetVPCRoutingTableWithContext(l.context, l.sess.NewGetVPCRoutingTableOptions(vpcID, id))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error Getting VPC Routing table: %s\n%s", err, response)
+	}
+	routes, err := listVPCRoutingTableRoutes(l.sess, vpcID, id)
+	if err != nil {
+		return nil, err
+	}
+	table := &netpath.RoutingTable{
+		ID:   id,
+		Name: *routingTable.Name,
+	}
+	for _, route := range routes {
+		r := netpath.Route{
+			ID:          *route.ID,
+			Name:        *route.Name,
+			Destination: *route.Destination,
+			Action:      *route.Action,
+		}
+		if route.Zone != nil {
+			r.Zone = *route.Zone.Name
+		}
+		if route.Priority != nil {
+			r.Priority = int(*route.Priority)
+		}
+		if nextHop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && nextHop != nil {
+			if nextHop.Address != nil {
+				r.NextHop = *nextHop.Address
+			} else if nextHop.ID != nil {
+				r.NextHop = *nextHop.ID
+			}
+		}
+		table.Routes = append(table.Routes, r)
+	}
+	l.routingTables[id] = table
+	return table, nil
+}
+
+func (l *networkPathLoader) securityGroup(id string) (netpath.SecurityGroup, error) {
+	if group, ok := l.securityGroups[id]; ok {
+		return group, nil
+	}
+	sg, response, err := l.sess.GetSecurityGroupWithContext(l.context, &vpcv1.GetSecurityGroupOptions{ID: &id})
+	if err != nil {
+		return netpath.SecurityGroup{}, fmt.Errorf("[ERROR] Error getting Security Group (%s): %s\n%s", id, err, response)
+	}
+	group := netpath.SecurityGroup{
+		ID:   id,
+		Name: *sg.Name,
+	}
+	for _, rulex := range sg.Rules {
+		var rule netpath.SecurityGroupRule
+		switch rulex := rulex.(type) {
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+			rule = netpath.SecurityGroupRule{ID: *rulex.ID, Direction: *rulex.Direction, Protocol: *rulex.Protocol,
+				Remote: securityGroupRuleRemote(rulex.Remote), Local: securityGroupRuleLocal(rulex.Local)}
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+			rule = netpath.SecurityGroupRule{ID: *rulex.ID, Direction: *rulex.Direction, Protocol: *rulex.Protocol,
+				Remote: securityGroupRuleRemote(rulex.Remote), Local: securityGroupRuleLocal(rulex.Local),
+				ICMPType: int64PtrToIntPtr(rulex.Type), ICMPCode: int64PtrToIntPtr(rulex.Code)}
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+			rule = netpath.SecurityGroupRule{ID: *rulex.ID, Direction: *rulex.Direction, Protocol: *rulex.Protocol,
+				Remote: securityGroupRuleRemote(rulex.Remote), Local: securityGroupRuleLocal(rulex.Local),
+				Ports: netpath.PortRange{Min: checkNetworkACLNil(rulex.PortMin), Max: checkNetworkACLNil(rulex.PortMax)}}
+		default:
+			continue
+		}
+		group.Rules = append(group.Rules, rule)
+	}
+	l.securityGroups[id] = group
+	return group, nil
+}
+
+func int64PtrToIntPtr(i *int64) *int {
+	if i == nil {
+		return nil
+	}
+	v := int(*i)
+	return &v
+}
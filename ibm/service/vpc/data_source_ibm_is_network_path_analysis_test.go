@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsNetworkPathAnalysisDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-npa-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-npa-subnet-%d", acctest.RandIntRange(10, 100))
+	sgname := fmt.Sprintf("tf-npa-sg-%d", acctest.RandIntRange(10, 100))
+	vniname := fmt.Sprintf("tf-npa-vni-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsNetworkPathAnalysisDataSourceConfig(vpcname, subnetname, sgname, vniname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.https", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.https", "hops.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.https", "hops.1.name", "destination_security_groups"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_network_path_analysis.https", "hops.1.resource_id", "ibm_is_security_group.testacc_sg", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.ssh", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.ssh", "hops.1.allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_path_analysis.ssh", "hops.1.rule_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsNetworkPathAnalysisDataSourceConfig(vpcname, subnetname, sgname, vniname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name                     = "%s"
+		vpc                      = ibm_is_vpc.testacc_vpc.id
+		zone                     = "%s"
+		total_ipv4_address_count = 16
+	}
+
+	resource "ibm_is_security_group" "testacc_sg" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+
+		rule {
+			direction = "inbound"
+			remote    = "192.168.0.0/16"
+			tcp {
+				port_min = 443
+				port_max = 443
+			}
+		}
+	}
+
+	resource "ibm_is_virtual_network_interface" "testacc_vni" {
+		name            = "%s"
+		subnet          = ibm_is_subnet.testacc_subnet.id
+		security_groups = [ibm_is_security_group.testacc_sg.id]
+	}
+
+	data "ibm_is_network_path_analysis" "https" {
+		source {
+			cidr = "192.168.1.0/24"
+		}
+		destination {
+			virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni.id
+		}
+		protocol = "tcp"
+		port     = 443
+	}
+
+	data "ibm_is_network_path_analysis" "ssh" {
+		source {
+			cidr = "192.168.1.0/24"
+		}
+		destination {
+			virtual_network_interface = ibm_is_virtual_network_interface.testacc_vni.id
+		}
+		protocol = "tcp"
+		port     = 22
+	}
+	`, vpcname, subnetname, acc.ISZoneName, sgname, vniname)
+}
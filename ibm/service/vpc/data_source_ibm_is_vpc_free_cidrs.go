@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPCFreeCIDRsVPC               = "vpc"
+	isVPCFreeCIDRsZone              = "zone"
+	isVPCFreeCIDRsPrefixLengths     = "prefix_lengths"
+	isVPCFreeCIDRsExclude           = "exclude"
+	isVPCFreeCIDRsSubnetNames       = "subnet_names"
+	isVPCFreeCIDRsCIDRs             = "cidrs"
+	isVPCFreeCIDRsAllocations       = "allocations"
+	isVPCFreeCIDRsCIDR              = "cidr"
+	isVPCFreeCIDRsPrefixLength      = "prefix_length"
+	isVPCFreeCIDRsAddressPrefix     = "address_prefix"
+	isVPCFreeCIDRsAddressPrefixName = "address_prefix_name"
+)
+
+// DataSourceIBMIsVPCFreeCIDRs computes CIDR blocks of the requested sizes that
+// are free in the address prefixes of a VPC zone.
+func DataSourceIBMIsVPCFreeCIDRs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPCFreeCIDRsRead,
+
+		Schema: map[string]*schema.Schema{
+			isVPCFreeCIDRsVPC: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier.",
+			},
+			isVPCFreeCIDRsZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The zone whose address prefixes the CIDR blocks are allocated from.",
+			},
+			isVPCFreeCIDRsPrefixLengths: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validate.ValidateAllowedRangeInt(8, 29)},
+				Description: "The prefix lengths of the CIDR blocks to allocate.",
+			},
+			isVPCFreeCIDRsExclude: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.ValidateCIDR},
+				Description: "Additional CIDR blocks the allocated CIDR blocks must not overlap.",
+			},
+			isVPCFreeCIDRsSubnetNames: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the subnets created from the CIDR blocks, in the order of prefix_lengths. A subnet whose CIDR block is the one allocated to its position keeps it, rather than its CIDR block being treated as used.",
+			},
+			isVPCFreeCIDRsCIDRs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The allocated CIDR blocks, in the order of prefix_lengths.",
+			},
+			isVPCFreeCIDRsAllocations: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The allocated CIDR blocks and the address prefixes containing them, in the order of prefix_lengths.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isVPCFreeCIDRsCIDR: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The allocated CIDR block.",
+						},
+						isVPCFreeCIDRsPrefixLength: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The prefix length of the CIDR block.",
+						},
+						isVPCFreeCIDRsAddressPrefix: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the address prefix containing the CIDR block.",
+						},
+						isVPCFreeCIDRsAddressPrefixName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the address prefix containing the CIDR block.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPCFreeCIDRsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vpcID := d.Get(isVPCFreeCIDRsVPC).(string)
+	zone := d.Get(isVPCFreeCIDRsZone).(string)
+
+	addressPrefixes := []vpcv1.AddressPrefix{}
+	start := ""
+	for {
+		listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			listVpcAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixCollection, response, err := sess.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing address prefixes of VPC (%s): %s\n%s", vpcID, err, response))
+		}
+		for _, addressPrefix := range addressPrefixCollection.AddressPrefixes {
+			if *addressPrefix.Zone.Name == zone {
+				addressPrefixes = append(addressPrefixes, addressPrefix)
+			}
+		}
+		start = flex.GetNext(addressPrefixCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	if len(addressPrefixes) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] VPC (%s) has no address prefix in zone %s", vpcID, zone))
+	}
+
+	used := []netip.Prefix{}
+	subnetCIDRs := map[string]netip.Prefix{}
+	start = ""
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnets, response, err := sess.ListSubnetsWithContext(context, listSubnetsOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing subnets of VPC (%s): %s\n%s", vpcID, err, response))
+		}
+		for _, subnet := range subnets.Subnets {
+			if subnet.Ipv4CIDRBlock == nil {
+				continue
+			}
+			cidr, err := netip.ParsePrefix(*subnet.Ipv4CIDRBlock)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the CIDR block of subnet (%s): %s", *subnet.ID, err))
+			}
+			used = append(used, cidr.Masked())
+			if subnet.Zone != nil && *subnet.Zone.Name == zone {
+				subnetCIDRs[*subnet.Name] = cidr.Masked()
+			}
+		}
+		start = flex.GetNext(subnets.Next)
+		if start == "" {
+			break
+		}
+	}
+	for _, e := range d.Get(isVPCFreeCIDRsExclude).([]interface{}) {
+		cidr, err := netip.ParsePrefix(e.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error parsing the excluded CIDR block %s: %s", e, err))
+		}
+		if !cidr.Addr().Is4() {
+			continue
+		}
+		used = append(used, cidr.Masked())
+	}
+
+	prefixLengths := flex.ExpandIntList(d.Get(isVPCFreeCIDRsPrefixLengths).([]interface{}))
+	subnetNames := flex.ExpandStringList(d.Get(isVPCFreeCIDRsSubnetNames).([]interface{}))
+	if len(subnetNames) > len(prefixLengths) {
+		return diag.FromErr(fmt.Errorf("[ERROR] %s has %d names, more than the %d prefix lengths", isVPCFreeCIDRsSubnetNames, len(subnetNames), len(prefixLengths)))
+	}
+	existing := make([]netip.Prefix, len(subnetNames))
+	for i, name := range subnetNames {
+		existing[i] = subnetCIDRs[name]
+	}
+
+	allocations, err := allocateStableVPCFreeCIDRs(addressPrefixes, used, existing, prefixLengths)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cidrs := make([]string, 0, len(allocations))
+	allocationList := make([]map[string]interface{}, 0, len(allocations))
+	for _, allocation := range allocations {
+		cidrs = append(cidrs, allocation.cidr.String())
+		allocationList = append(allocationList, map[string]interface{}{
+			isVPCFreeCIDRsCIDR:              allocation.cidr.String(),
+			isVPCFreeCIDRsPrefixLength:      allocation.cidr.Bits(),
+			isVPCFreeCIDRsAddressPrefix:     *allocation.addressPrefix.ID,
+			isVPCFreeCIDRsAddressPrefixName: *allocation.addressPrefix.Name,
+		})
+	}
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set(isVPCFreeCIDRsCIDRs, cidrs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cidrs %s", err))
+	}
+	if err = d.Set(isVPCFreeCIDRsAllocations, allocationList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting allocations %s", err))
+	}
+	return nil
+}
+
+type vpcFreeCIDRAllocation struct {
+	cidr          netip.Prefix
+	addressPrefix vpcv1.AddressPrefix
+}
+
+// allocateVPCFreeCIDRs allocates a CIDR block for each of prefixLengths from
+// addressPrefixes. The blocks overlap neither the used blocks, the reserved
+// address ranges nor each other. Larger blocks are allocated first so that
+// smaller blocks fill the remaining gaps, and each block takes the lowest free
+// address, which keeps the result stable between reads.
+func allocateVPCFreeCIDRs(addressPrefixes []vpcv1.AddressPrefix, used []netip.Prefix, prefixLengths []int) ([]vpcFreeCIDRAllocation, error) {
+	type addressPrefixRange struct {
+		prefix        netip.Prefix
+		addressPrefix vpcv1.AddressPrefix
+	}
+	ranges := make([]addressPrefixRange, 0, len(addressPrefixes))
+	for _, addressPrefix := range addressPrefixes {
+		prefix, err := netip.ParsePrefix(*addressPrefix.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error parsing the CIDR block of address prefix (%s): %s", *addressPrefix.ID, err)
+		}
+		if !prefix.Addr().Is4() {
+			continue
+		}
+		ranges = append(ranges, addressPrefixRange{prefix: prefix.Masked(), addressPrefix: addressPrefix})
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].prefix.Addr().Less(ranges[j].prefix.Addr())
+	})
+
+	order := make([]int, len(prefixLengths))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return prefixLengths[order[i]] < prefixLengths[order[j]]
+	})
+
+	used = append([]netip.Prefix{}, used...)
+	allocations := make([]vpcFreeCIDRAllocation, len(prefixLengths))
+	for _, i := range order {
+		found := false
+		for _, r := range ranges {
+			cidr, ok := freeCIDRInPrefix(r.prefix, prefixLengths[i], used)
+			if ok {
+				allocations[i] = vpcFreeCIDRAllocation{cidr: cidr, addressPrefix: r.addressPrefix}
+				used = append(used, cidr)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("[ERROR] No free /%d CIDR block left in the address prefixes", prefixLengths[i])
+		}
+	}
+	return allocations, nil
+}
+
+// allocateStableVPCFreeCIDRs allocates a CIDR block for each of prefixLengths,
+// like allocateVPCFreeCIDRs, keeping the blocks of the subnets created from an
+// earlier read. existing holds, for each position, the CIDR block of the
+// subnet created for it, if any. When it matches the block allocated to that
+// position with the existing blocks free, the position stays allocated to the
+// subnet rather than the subnet's block counting as used. Otherwise the block
+// counts as used, and the allocation is computed again.
+func allocateStableVPCFreeCIDRs(addressPrefixes []vpcv1.AddressPrefix, used []netip.Prefix, existing []netip.Prefix, prefixLengths []int) ([]vpcFreeCIDRAllocation, error) {
+	kept := make(map[netip.Prefix]bool)
+	for i, cidr := range existing {
+		if i < len(prefixLengths) && cidr.IsValid() && cidr.Bits() == prefixLengths[i] {
+			kept[cidr] = true
+		}
+	}
+	for {
+		free := make([]netip.Prefix, 0, len(used))
+		for _, u := range used {
+			if !kept[u] {
+				free = append(free, u)
+			}
+		}
+		allocations, err := allocateVPCFreeCIDRs(addressPrefixes, free, prefixLengths)
+		if err != nil {
+			return nil, err
+		}
+		stable := true
+		for i, cidr := range existing {
+			if kept[cidr] && (i >= len(allocations) || allocations[i].cidr != cidr) {
+				delete(kept, cidr)
+				stable = false
+			}
+		}
+		if stable {
+			return allocations, nil
+		}
+	}
+}
+
+// freeCIDRInPrefix returns the lowest block of the given prefix length in prefix
+// that overlaps neither the used blocks nor the reserved address ranges.
+func freeCIDRInPrefix(prefix netip.Prefix, prefixLength int, used []netip.Prefix) (netip.Prefix, bool) {
+	if prefixLength < prefix.Bits() {
+		return netip.Prefix{}, false
+	}
+	first := ipv4ToUint32(prefix.Addr())
+	last := uint64(first) + 1<<(32-prefix.Bits()) - 1
+	size := uint64(1) << (32 - prefixLength)
+
+	for start := uint64(first); start+size-1 <= last; {
+		candidate := netip.PrefixFrom(uint32ToIPv4(uint32(start)), prefixLength)
+		next := start + size
+		free := true
+		for _, u := range used {
+			if candidate.Overlaps(u) {
+				free = false
+				// skip past the used block, keeping the candidate aligned
+				end := uint64(ipv4ToUint32(u.Masked().Addr())) + 1<<(32-u.Bits())
+				if end > next {
+					next = (end + size - 1) / size * size
+				}
+			}
+		}
+		if free && !validate.OverlapsReservedAddressRange(candidate.String()) {
+			return candidate, true
+		}
+		start = next
+	}
+	return netip.Prefix{}, false
+}
+
+func ipv4ToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return binary.BigEndian.Uint32(b[:])
+}
+
+func uint32ToIPv4(v uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return netip.AddrFrom4(b)
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsVPCFreeCIDRsDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-freecidrs-vpc-%d", acctest.RandIntRange(10, 100))
+	prefixname := fmt.Sprintf("tf-freecidrs-prefix-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-freecidrs-subnet-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCFreeCIDRsDataSourceConfig(vpcname, prefixname, subnetname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_free_cidrs.testacc_free_cidrs", "cidrs.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_free_cidrs.testacc_free_cidrs", "cidrs.0", "10.120.0.64/26"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_free_cidrs.testacc_free_cidrs", "cidrs.1", "10.120.0.192/28"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_free_cidrs.testacc_free_cidrs", "cidrs.2", "10.120.0.128/26"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_free_cidrs.testacc_free_cidrs", "allocations.1.prefix_length", "28"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_vpc_free_cidrs.testacc_free_cidrs", "allocations.0.address_prefix", "ibm_is_vpc_address_prefix.testacc_vpc_address_prefix", "address_prefix"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPCFreeCIDRsDataSourceConfig(vpcname, prefixname, subnetname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name                      = "%s"
+		address_prefix_management = "manual"
+	}
+
+	resource "ibm_is_vpc_address_prefix" "testacc_vpc_address_prefix" {
+		name = "%s"
+		zone = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		cidr = "10.120.0.0/24"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "10.120.0.0/26"
+		depends_on      = [ibm_is_vpc_address_prefix.testacc_vpc_address_prefix]
+	}
+
+	data "ibm_is_vpc_free_cidrs" "testacc_free_cidrs" {
+		vpc            = ibm_is_vpc.testacc_vpc.id
+		zone           = "%s"
+		prefix_lengths = [26, 28, 26]
+		depends_on     = [ibm_is_subnet.testacc_subnet]
+	}
+	`, vpcname, prefixname, acc.ISZoneName, subnetname, acc.ISZoneName, acc.ISZoneName)
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func freeCIDRsAddressPrefix(id, cidr string) vpcv1.AddressPrefix {
+	return vpcv1.AddressPrefix{ID: core.StringPtr(id), Name: core.StringPtr(id + "-name"), CIDR: core.StringPtr(cidr)}
+}
+
+func freeCIDRsPrefixes(cidrs ...string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefixes = append(prefixes, netip.MustParsePrefix(cidr))
+	}
+	return prefixes
+}
+
+func freeCIDRsStrings(allocations []vpcFreeCIDRAllocation) []string {
+	cidrs := make([]string, 0, len(allocations))
+	for _, allocation := range allocations {
+		cidrs = append(cidrs, allocation.cidr.String())
+	}
+	return cidrs
+}
+
+func TestFreeCIDRInPrefix(t *testing.T) {
+	testcases := []struct {
+		prefix       string
+		prefixLength int
+		used         []string
+		cidr         string
+	}{
+		{"10.0.0.0/24", 26, nil, "10.0.0.0/26"},
+		// the candidate after a smaller used block stays aligned
+		{"10.0.0.0/24", 26, []string{"10.0.0.0/28"}, "10.0.0.64/26"},
+		{"10.0.0.0/24", 25, []string{"10.0.0.64/27"}, "10.0.0.128/25"},
+		// a larger used block is skipped at once
+		{"10.0.0.0/22", 26, []string{"10.0.0.0/23", "10.0.2.0/26"}, "10.0.2.64/26"},
+		// the reserved address ranges are never allocated
+		{"161.24.0.0/14", 16, []string{"161.24.0.0/16", "161.25.0.0/16"}, "161.27.0.0/16"},
+		// exhaustion
+		{"10.0.0.0/24", 25, []string{"10.0.0.0/25", "10.0.0.192/26"}, ""},
+		{"10.0.0.0/24", 23, nil, ""},
+	}
+	for _, tc := range testcases {
+		cidr, ok := freeCIDRInPrefix(netip.MustParsePrefix(tc.prefix), tc.prefixLength, freeCIDRsPrefixes(tc.used...))
+		if tc.cidr == "" {
+			assert.False(t, ok, "%s /%d", tc.prefix, tc.prefixLength)
+			continue
+		}
+		assert.True(t, ok, "%s /%d", tc.prefix, tc.prefixLength)
+		assert.Equal(t, tc.cidr, cidr.String(), "%s /%d", tc.prefix, tc.prefixLength)
+	}
+}
+
+func TestAllocateVPCFreeCIDRs(t *testing.T) {
+	addressPrefixes := []vpcv1.AddressPrefix{
+		freeCIDRsAddressPrefix("ap-2", "10.0.4.0/24"),
+		freeCIDRsAddressPrefix("ap-v6", "fd00::/64"),
+		freeCIDRsAddressPrefix("ap-1", "10.0.0.0/22"),
+	}
+
+	// larger blocks are allocated first, the output keeps the order of the prefix lengths
+	allocations, err := allocateVPCFreeCIDRs(addressPrefixes, nil, []int{28, 24, 26})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.1.64/28", "10.0.0.0/24", "10.0.1.0/26"}, freeCIDRsStrings(allocations))
+	assert.Equal(t, "ap-1", *allocations[0].addressPrefix.ID)
+
+	// the blocks overlap neither the used blocks nor each other, and spill over to the next address prefix
+	allocations, err = allocateVPCFreeCIDRs(addressPrefixes, freeCIDRsPrefixes("10.0.0.0/23", "10.0.2.0/24"), []int{24, 24})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.3.0/24", "10.0.4.0/24"}, freeCIDRsStrings(allocations))
+	assert.Equal(t, "ap-2", *allocations[1].addressPrefix.ID)
+	assert.Equal(t, "ap-2-name", *allocations[1].addressPrefix.Name)
+
+	// exhaustion
+	_, err = allocateVPCFreeCIDRs(addressPrefixes, freeCIDRsPrefixes("10.0.0.0/22"), []int{24, 24})
+	assert.ErrorContains(t, err, "No free /24 CIDR block")
+}
+
+func TestAllocateStableVPCFreeCIDRs(t *testing.T) {
+	addressPrefixes := []vpcv1.AddressPrefix{freeCIDRsAddressPrefix("ap-1", "10.0.0.0/22")}
+	other := netip.MustParsePrefix("10.0.1.0/24")
+
+	// the first read, before the subnets exist
+	allocations, err := allocateStableVPCFreeCIDRs(addressPrefixes, []netip.Prefix{other}, nil, []int{24, 26})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/24", "10.0.2.0/26"}, freeCIDRsStrings(allocations))
+
+	// the subnets created from the first read keep their blocks
+	subnets := freeCIDRsPrefixes("10.0.0.0/24", "10.0.2.0/26")
+	used := append([]netip.Prefix{other}, subnets...)
+	allocations, err = allocateStableVPCFreeCIDRs(addressPrefixes, used, subnets, []int{24, 26})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/24", "10.0.2.0/26"}, freeCIDRsStrings(allocations))
+
+	// without their names they are used like any other subnet
+	allocations, err = allocateStableVPCFreeCIDRs(addressPrefixes, used, nil, []int{24, 26})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.3.0/24", "10.0.2.64/26"}, freeCIDRsStrings(allocations))
+
+	// a subnet whose block is not the one allocated to its position is used,
+	// and the allocation is computed again
+	subnets = freeCIDRsPrefixes("10.0.3.0/24", "10.0.2.0/26")
+	used = append([]netip.Prefix{other}, subnets...)
+	allocations, err = allocateStableVPCFreeCIDRs(addressPrefixes, used, subnets, []int{24, 26})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/24", "10.0.2.0/26"}, freeCIDRsStrings(allocations))
+
+	// a subnet whose prefix length changed is used
+	subnets = freeCIDRsPrefixes("10.0.0.0/24")
+	used = append([]netip.Prefix{other}, subnets...)
+	allocations, err = allocateStableVPCFreeCIDRs(addressPrefixes, used, subnets, []int{25})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.2.0/25"}, freeCIDRsStrings(allocations))
+}
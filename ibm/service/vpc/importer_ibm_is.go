@@ -0,0 +1,335 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/importer"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// ImportListers returns the listers of the VPC objects of the region of the
+// provider, which can be filtered by the resource_group_id option.
+func ImportListers() map[string]importer.Lister {
+	return map[string]importer.Lister{
+		"ibm_is_vpc":                 listIsVpcImports,
+		"ibm_is_subnet":              listIsSubnetImports,
+		"ibm_is_security_group":      listIsSecurityGroupImports,
+		"ibm_is_security_group_rule": listIsSecurityGroupRuleImports,
+		"ibm_is_network_acl":         listIsNetworkACLImports,
+		"ibm_is_instance":            listIsInstanceImports,
+		"ibm_is_volume":              listIsVolumeImports,
+		"ibm_is_floating_ip":         listIsFloatingIPImports,
+		"ibm_is_public_gateway":      listIsPublicGatewayImports,
+		"ibm_is_ssh_key":             listIsSSHKeyImports,
+	}
+}
+
+func listIsVpcImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	start := ""
+	for {
+		listVpcsOptions := &vpcv1.ListVpcsOptions{}
+		if rg := options["resource_group_id"]; rg != "" {
+			listVpcsOptions.ResourceGroupID = &rg
+		}
+		if start != "" {
+			listVpcsOptions.Start = &start
+		}
+		vpcs, response, err := sess.ListVpcs(listVpcsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching vpcs %s\n%s", err, response)
+		}
+		for _, vpc := range vpcs.Vpcs {
+			items = append(items, importer.Item{ResourceType: "ibm_is_vpc", ID: *vpc.ID, Name: *vpc.Name})
+		}
+		start = flex.GetNext(vpcs.Next)
+		if start == "" {
+			break
+		}
+	}
+	return items, nil
+}
+
+func listIsSubnetImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	start := ""
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{}
+		if rg := options["resource_group_id"]; rg != "" {
+			listSubnetsOptions.ResourceGroupID = &rg
+		}
+		if start != "" {
+			listSubnetsOptions.Start = &start
+		}
+		subnets, response, err := sess.ListSubnets(listSubnetsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
+		}
+		for _, subnet := range subnets.Subnets {
+			items = append(items, importer.Item{ResourceType: "ibm_is_subnet", ID: *subnet.ID, Name: *subnet.Name})
+		}
+		start = flex.GetNext(subnets.Next)
+		if start == "" {
+			break
+		}
+	}
+	return items, nil
+}
+
+func listIsSecurityGroups(meta interface{}, options map[string]string) ([]vpcv1.SecurityGroup, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var allrecs []vpcv1.SecurityGroup
+	start := ""
+	for {
+		listSecurityGroupsOptions := &vpcv1.ListSecurityGroupsOptions{}
+		if rg := options["resource_group_id"]; rg != "" {
+			listSecurityGroupsOptions.ResourceGroupID = &rg
+		}
+		if start != "" {
+			listSecurityGroupsOptions.Start = &start
+		}
+		groups, response, err := sess.ListSecurityGroups(listSecurityGroupsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching security groups %s\n%s", err, response)
+		}
+		allrecs = append(allrecs, groups.SecurityGroups...)
+		start = flex.GetNext(groups.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+func listIsSecurityGroupImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	groups, err := listIsSecurityGroups(meta, options)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	for _, group := range groups {
+		items = append(items, importer.Item{ResourceType: "ibm_is_security_group", ID: *group.ID, Name: *group.Name})
+	}
+	return items, nil
+}
+
+// listIsSecurityGroupRuleImports lists the rules of the security groups,
+// which are named after their group and direction.
+func listIsSecurityGroupRuleImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	groups, err := listIsSecurityGroups(meta, options)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	for _, group := range groups {
+		for _, ruleIntf := range group.Rules {
+			rule, ok := ruleIntf.(*vpcv1.SecurityGroupRule)
+			if !ok || rule.ID == nil {
+				continue
+			}
+			name := *group.Name
+			if rule.Direction != nil {
+				name = name + "_" + *rule.Direction
+			}
+			items = append(items, importer.Item{ResourceType: "ibm_is_security_group_rule", ID: makeTerraformRuleID(*group.ID, *rule.ID), Name: name})
+		}
+	}
+	return items, nil
+}
+
+func listIsNetworkACLImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	start := ""
+	for {
+		listNetworkAclsOptions := &vpcv1.ListNetworkAclsOptions{}
+		if rg := options["resource_group_id"]; rg != "" {
+			listNetworkAclsOptions.ResourceGroupID = &rg
+		}
+		if start != "" {
+			listNetworkAclsOptions.Start = &start
+		}
+		acls, response, err := sess.ListNetworkAcls(listNetworkAclsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching network acls %s\n%s", err, response)
+		}
+		for _, acl := range acls.NetworkAcls {
+			items = append(items, importer.Item{ResourceType: "ibm_is_network_acl", ID: *acl.ID, Name: *acl.Name})
+		}
+		start = flex.GetNext(acls.Next)
+		if start == "" {
+			break
+		}
+	}
+	return items, nil
+}
+
+func listIsInstanceImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	start := ""
+	for {
+		listInstancesOptions := &vpcv1.ListInstancesOptions{}
+		if rg := options["resource_group_id"]; rg != "" {
+			listInstancesOptions.ResourceGroupID = &rg
+		}
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		instances, response, err := sess.ListInstances(listInstancesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching instances %s\n%s", err, response)
+		}
+		for _, instance := range instances.Instances {
+			items = append(items, importer.Item{ResourceType: "ibm_is_instance", ID: *instance.ID, Name: *instance.Name})
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			break
+		}
+	}
+	return items, nil
+}
+
+// listIsVolumeImports lists the volumes, which cannot be filtered by resource
+// group on the server side.
+func listIsVolumeImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	start := ""
+	for {
+		listVolumesOptions := &vpcv1.ListVolumesOptions{}
+		if start != "" {
+			listVolumesOptions.Start = &start
+		}
+		volumes, response, err := sess.ListVolumes(listVolumesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching volumes %s\n%s", err, response)
+		}
+		for _, volume := range volumes.Volumes {
+			if rg := options["resource_group_id"]; rg != "" && (volume.ResourceGroup == nil || *volume.ResourceGroup.ID != rg) {
+				continue
+			}
+			items = append(items, importer.Item{ResourceType: "ibm_is_volume", ID: *volume.ID, Name: *volume.Name})
+		}
+		start = flex.GetNext(volumes.Next)
+		if start == "" {
+			break
+		}
+	}
+	return items, nil
+}
+
+func listIsFloatingIPImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	start := ""
+	for {
+		listFloatingIpsOptions := &vpcv1.ListFloatingIpsOptions{}
+		if rg := options["resource_group_id"]; rg != "" {
+			listFloatingIpsOptions.ResourceGroupID = &rg
+		}
+		if start != "" {
+			listFloatingIpsOptions.Start = &start
+		}
+		floatingIPs, response, err := sess.ListFloatingIps(listFloatingIpsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching floating ips %s\n%s", err, response)
+		}
+		for _, floatingIP := range floatingIPs.FloatingIps {
+			items = append(items, importer.Item{ResourceType: "ibm_is_floating_ip", ID: *floatingIP.ID, Name: *floatingIP.Name})
+		}
+		start = flex.GetNext(floatingIPs.Next)
+		if start == "" {
+			break
+		}
+	}
+	return items, nil
+}
+
+func listIsPublicGatewayImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	start := ""
+	for {
+		listPublicGatewaysOptions := &vpcv1.ListPublicGatewaysOptions{}
+		if rg := options["resource_group_id"]; rg != "" {
+			listPublicGatewaysOptions.ResourceGroupID = &rg
+		}
+		if start != "" {
+			listPublicGatewaysOptions.Start = &start
+		}
+		publicGateways, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching public gateways %s\n%s", err, response)
+		}
+		for _, publicGateway := range publicGateways.PublicGateways {
+			items = append(items, importer.Item{ResourceType: "ibm_is_public_gateway", ID: *publicGateway.ID, Name: *publicGateway.Name})
+		}
+		start = flex.GetNext(publicGateways.Next)
+		if start == "" {
+			break
+		}
+	}
+	return items, nil
+}
+
+func listIsSSHKeyImports(meta interface{}, options map[string]string) ([]importer.Item, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	var items []importer.Item
+	start := ""
+	for {
+		listKeysOptions := &vpcv1.ListKeysOptions{}
+		if rg := options["resource_group_id"]; rg != "" {
+			listKeysOptions.ResourceGroupID = &rg
+		}
+		if start != "" {
+			listKeysOptions.Start = &start
+		}
+		keys, response, err := sess.ListKeys(listKeysOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Fetching keys %s\n%s", err, response)
+		}
+		for _, key := range keys.Keys {
+			items = append(items, importer.Item{ResourceType: "ibm_is_ssh_key", ID: *key.ID, Name: *key.Name})
+		}
+		start = flex.GetNext(keys.Next)
+		if start == "" {
+			break
+		}
+	}
+	return items, nil
+}
@@ -226,6 +226,10 @@ func resourceIBMIsBackupPolicyCreate(context context.Context, d *schema.Resource
 	} else if matchResourceTypes, ok := d.GetOk("match_resource_types"); ok {
 		matchResourceTypeList := flex.ExpandStringList((matchResourceTypes.(*schema.Set)).List())
 		backupPolicyPrototype.MatchResourceType = core.StringPtr(matchResourceTypeList[0])
+		if len(matchResourceTypeList) > 1 {
+			flex.AddWarning(d, fmt.Sprintf("Only %q of match_resource_types is used as the match_resource_type of the backup policy", matchResourceTypeList[0]),
+				fmt.Sprintf("A backup policy matches a single resource type, the other values %q are ignored. Use match_resource_type instead of the deprecated match_resource_types.", matchResourceTypeList[1:]))
+		}
 	}
 	if _, ok := d.GetOk("included_content"); ok {
 		backupPolicyPrototype.IncludedContent = flex.ExpandStringList((d.Get("included_content").(*schema.Set)).List())
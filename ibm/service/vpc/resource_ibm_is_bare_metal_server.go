@@ -2061,16 +2061,16 @@ func resourceIBMISBareMetalServerCreate(context context.Context, d *schema.Resou
 		oldList, newList := d.GetChange(isBareMetalServerTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerUserTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource bare metal server (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource bare metal server (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isBareMetalServerAccessTags); ok {
 		oldList, newList := d.GetChange(isBareMetalServerAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *bms.CRN, "", isBareMetalServerAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource bare metal server (%s) access tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource bare metal server (%s) access tags: %s", d.Id(), err)
 		}
 	}
 
@@ -3154,16 +3154,16 @@ func bareMetalServerUpdate(context context.Context, d *schema.ResourceData, meta
 			oldList, newList := d.GetChange(isBareMetalServerTags)
 			err = flex.UpdateTagsUsingCRN(oldList, newList, meta, bmscrn)
 			if err != nil {
-				log.Printf(
-					"[ERROR] Error on update of vpc Bare metal server (%s) tags: %s", id, err)
+				flex.AddWarningf(d,
+					"Error on update of vpc Bare metal server (%s) tags: %s", id, err)
 			}
 		}
 		if d.HasChange(isBareMetalServerAccessTags) {
 			oldList, newList := d.GetChange(isBareMetalServerAccessTags)
 			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, bmscrn, "", isBareMetalServerAccessTagType)
 			if err != nil {
-				log.Printf(
-					"[ERROR] Error on update of resource vpc Bare metal server (%s) access tags: %s", id, err)
+				flex.AddWarningf(d,
+					"Error on update of resource vpc Bare metal server (%s) access tags: %s", id, err)
 			}
 		}
 	}
@@ -484,7 +484,7 @@ func resourceIbmIsDedicatedHostCreate(context context.Context, d *schema.Resourc
 		oldList, newList := d.GetChange(isDedicatedHostAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *dedicatedHost.CRN, "", isDedicatedHostAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource dedicated host (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -701,7 +701,7 @@ func resourceIbmIsDedicatedHostUpdate(context context.Context, d *schema.Resourc
 		oldList, newList := d.GetChange(isDedicatedHostAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isDedicatedHostAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource dedicated host (%s) access tags: %s", d.Id(), err)
 		}
 	}
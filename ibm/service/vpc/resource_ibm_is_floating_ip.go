@@ -380,7 +380,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of vpc Floating IP (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -389,7 +389,7 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		oldList, newList := d.GetChange(isFloatingIPAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *floatingip.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource Floating IP (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -494,7 +494,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		oldList, newList := d.GetChange(isFloatingIPTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *fip.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of vpc Floating IP (%s) tags: %s", id, err)
 		}
 	}
@@ -510,7 +510,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		oldList, newList := d.GetChange(isFloatingIPAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *fip.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource Floating IP (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -267,7 +267,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc flow log (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -275,7 +275,7 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 		oldList, newList := d.GetChange(isFlowLogAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource VPC Flow Log (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -390,7 +390,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		oldList, newList := d.GetChange(isFlowLogTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource flow log (%s) tags: %s", *flowlogCollector.ID, err)
 		}
 	}
@@ -399,7 +399,7 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		oldList, newList := d.GetChange(isFlowLogAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource flow log (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -379,7 +379,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -387,7 +387,7 @@ func imgCreateByFile(d *schema.ResourceData, meta interface{}, href, name, opera
 		oldList, newList := d.GetChange(isImageAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc Image (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -494,7 +494,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc Image (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -502,7 +502,7 @@ func imgCreateByVolume(d *schema.ResourceData, meta interface{}, name, volume st
 		oldList, newList := d.GetChange(isImageAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc Image (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -607,7 +607,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		oldList, newList := d.GetChange(isImageTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpc Image (%s) tags: %s", id, err)
 		}
 	}
@@ -622,7 +622,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 		oldList, newList := d.GetChange(isImageAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *image.CRN, "", isImageAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpc Image (%s) access tags: %s", id, err)
 		}
 	}
@@ -2679,16 +2679,16 @@ func instanceCreateByImage(d *schema.ResourceData, meta interface{}, profile, na
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
 		}
 	}
 	return nil
@@ -3228,8 +3228,8 @@ func instanceCreateByCatalogOffering(d *schema.ResourceData, meta interface{}, p
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	return nil
@@ -3756,16 +3756,16 @@ func instanceCreateByTemplate(d *schema.ResourceData, meta interface{}, profile,
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
 		}
 	}
 	return nil
@@ -4296,16 +4296,16 @@ func instanceCreateBySnapshot(d *schema.ResourceData, meta interface{}, profile,
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceUserTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
 		}
 	}
 	return nil
@@ -4790,16 +4790,16 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if _, ok := d.GetOk(isInstanceAccessTags); ok {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource instance (%s) access tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource instance (%s) access tags: %s", d.Id(), err)
 		}
 	}
 	return nil
@@ -6906,16 +6906,16 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		oldList, newList := d.GetChange(isInstanceTags)
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on update of resource Instance (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on update of resource Instance (%s) tags: %s", d.Id(), err)
 		}
 	}
 	if d.HasChange(isInstanceAccessTags) {
 		oldList, newList := d.GetChange(isInstanceAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on update of resource Instance (%s) access tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on update of resource Instance (%s) access tags: %s", d.Id(), err)
 		}
 	}
 	return nil
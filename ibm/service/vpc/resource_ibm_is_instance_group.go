@@ -29,6 +29,12 @@ const (
 	isInstanceGroupAccessTags    = "access_tags"
 	isInstanceGroupUserTagType   = "user"
 	isInstanceGroupAccessTagType = "access"
+
+	isInstanceGroupRollingUpdate                   = "rolling_update"
+	isInstanceGroupRollingUpdateBatchSize          = "batch_size"
+	isInstanceGroupRollingUpdateMaxUnavailable     = "max_unavailable"
+	isInstanceGroupRollingUpdateHealthCheckTimeout = "health_check_timeout"
+	isInstanceGroupRollingUpdatePause              = "pause_between_batches"
 )
 
 func ResourceIBMISInstanceGroup() *schema.Resource {
@@ -165,6 +171,45 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of access management tags",
 			},
+
+			isInstanceGroupRollingUpdate: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replace the memberships of the instance group in batches when the instance template changes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceGroupRollingUpdateBatchSize: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", isInstanceGroupRollingUpdateBatchSize),
+							Description:  "The number of memberships replaced in each batch",
+						},
+						isInstanceGroupRollingUpdateMaxUnavailable: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", isInstanceGroupRollingUpdateMaxUnavailable),
+							Description:  "The number of memberships of a batch that may be removed before their replacements are healthy",
+						},
+						isInstanceGroupRollingUpdateHealthCheckTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", isInstanceGroupRollingUpdateHealthCheckTimeout),
+							Description:  "The number of seconds to wait for the new memberships and their load balancer pool members to become healthy",
+						},
+						isInstanceGroupRollingUpdatePause: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", isInstanceGroupRollingUpdatePause),
+							Description:  "The number of seconds to wait between batches",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -195,6 +240,34 @@ func ResourceIBMISInstanceGroupValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeInt,
 			MinValue:                   "1",
 			MaxValue:                   "65535"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceGroupRollingUpdateBatchSize,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "1",
+			MaxValue:                   "1000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceGroupRollingUpdateMaxUnavailable,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "0",
+			MaxValue:                   "1000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceGroupRollingUpdateHealthCheckTimeout,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "60",
+			MaxValue:                   "7200"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isInstanceGroupRollingUpdatePause,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "0",
+			MaxValue:                   "3600"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 "tags",
@@ -287,7 +360,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of instance group (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -296,8 +369,8 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isInstanceGroupAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN, "", isInstanceGroupAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of instance group (%s) tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of instance group (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -311,6 +384,14 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// fail before the instance template is changed
+	if _, ok := d.GetOk(isInstanceGroupRollingUpdate); ok && d.HasChange("instance_template") {
+		err = checkInstanceGroupManagersDisabled(sess, d.Id())
+		if err != nil {
+			return err
+		}
+	}
+
 	var changed bool
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
@@ -325,7 +406,7 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of instance group (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -391,6 +472,15 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 			return healthError
 		}
 	}
+
+	// existing memberships keep the old template unless they are replaced
+	if _, ok := d.GetOk(isInstanceGroupRollingUpdate); ok && d.HasChange("instance_template") {
+		oldTemplate, newTemplate := d.GetChange("instance_template")
+		err = instanceGroupRollingUpdate(d, meta, sess, oldTemplate.(string), newTemplate.(string))
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMISInstanceGroupRead(d, meta)
 }
 
@@ -613,3 +703,326 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 	return healthStateConf.WaitForState()
 
 }
+
+// instanceGroupRollingUpdateSettings are the settings of the rolling_update block
+// and the load balancer pool whose members must become healthy.
+type instanceGroupRollingUpdateSettings struct {
+	batchSize, maxUnavailable int
+	healthCheckTimeout, pause time.Duration
+	lbID, lbPoolID            string
+}
+
+// checkInstanceGroupManagersDisabled fails when a manager of the instance group is
+// enabled, because it would change the membership count during a rolling update.
+func checkInstanceGroupManagersDisabled(sess *vpcv1.VpcV1, instanceGroupID string) error {
+	start := ""
+	for {
+		listInstanceGroupManagersOptions := vpcv1.ListInstanceGroupManagersOptions{
+			InstanceGroupID: &instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupManagersOptions.Start = &start
+		}
+		instanceGroupManagerCollection, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagersOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
+		}
+		for _, instanceGroupManagerIntf := range instanceGroupManagerCollection.Managers {
+			instanceGroupManager, ok := instanceGroupManagerIntf.(*vpcv1.InstanceGroupManager)
+			if ok && instanceGroupManager.ManagementEnabled != nil && *instanceGroupManager.ManagementEnabled {
+				return fmt.Errorf("[ERROR] Instance group manager (%s) of instance group (%s) must be disabled for a rolling update", *instanceGroupManager.ID, instanceGroupID)
+			}
+		}
+		start = flex.GetNext(instanceGroupManagerCollection.Next)
+		if start == "" {
+			return nil
+		}
+	}
+}
+
+// instanceGroupRollingUpdate replaces the memberships still running oldTemplate in
+// batches. A batch first scales the group out so that at most max_unavailable
+// memberships are missing, removes the old memberships, scales the group back and
+// waits for the new memberships to become healthy. If a batch fails the group is
+// rolled back to oldTemplate, batch by batch as well.
+func instanceGroupRollingUpdate(d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1, oldTemplate, newTemplate string) error {
+	rollingUpdate := d.Get(isInstanceGroupRollingUpdate).([]interface{})[0].(map[string]interface{})
+	settings := instanceGroupRollingUpdateSettings{
+		batchSize:          rollingUpdate[isInstanceGroupRollingUpdateBatchSize].(int),
+		maxUnavailable:     rollingUpdate[isInstanceGroupRollingUpdateMaxUnavailable].(int),
+		healthCheckTimeout: time.Duration(rollingUpdate[isInstanceGroupRollingUpdateHealthCheckTimeout].(int)) * time.Second,
+		pause:              time.Duration(rollingUpdate[isInstanceGroupRollingUpdatePause].(int)) * time.Second,
+		lbID:               d.Get("load_balancer").(string),
+		lbPoolID:           d.Get("load_balancer_pool").(string),
+	}
+
+	instanceGroupID := d.Id()
+	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
+	instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+	if err != nil || instanceGroup == nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+	}
+	membershipCount := *instanceGroup.MembershipCount
+
+	err = replaceInstanceGroupMembershipBatches(d, meta, sess, settings, membershipCount, newTemplate)
+	if err != nil {
+		rollbackErr := rollbackInstanceGroupRollingUpdate(d, meta, sess, settings, membershipCount, oldTemplate)
+		if rollbackErr != nil {
+			return fmt.Errorf("[ERROR] Error replacing memberships of instance group (%s): %s\n[ERROR] Error rolling back to instance template (%s): %s", instanceGroupID, err, oldTemplate, rollbackErr)
+		}
+		return fmt.Errorf("[ERROR] Error replacing memberships of instance group (%s), rolled back to instance template (%s): %s", instanceGroupID, oldTemplate, err)
+	}
+	return nil
+}
+
+// replaceInstanceGroupMembershipBatches replaces the memberships not running
+// template, batch_size memberships at a time.
+func replaceInstanceGroupMembershipBatches(d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1, settings instanceGroupRollingUpdateSettings, membershipCount int64, template string) error {
+	instanceGroupID := d.Id()
+	for batch := 1; ; batch++ {
+		memberships, err := listInstanceGroupMemberships(sess, instanceGroupID)
+		if err != nil {
+			return err
+		}
+		current := 0
+		outdated := make([]string, 0)
+		for _, membership := range memberships {
+			if *membership.Status == vpcv1.InstanceGroupMembershipStatusDeletingConst {
+				continue
+			}
+			if *membership.InstanceTemplate.ID == template {
+				current++
+			} else {
+				outdated = append(outdated, *membership.ID)
+			}
+		}
+		if len(outdated) == 0 {
+			return nil
+		}
+		if len(outdated) > settings.batchSize {
+			outdated = outdated[:settings.batchSize]
+		}
+		if batch > 1 && settings.pause > 0 {
+			time.Sleep(settings.pause)
+		}
+
+		log.Printf("[INFO] Replacing %d memberships of instance group (%s) with instance template (%s) in batch %d", len(outdated), instanceGroupID, template, batch)
+		err = replaceInstanceGroupMemberships(d, meta, sess, settings, outdated, current, membershipCount, template)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// replaceInstanceGroupMemberships replaces membershipIDs with memberships running
+// template, of which current are running already.
+func replaceInstanceGroupMemberships(d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1, settings instanceGroupRollingUpdateSettings, membershipIDs []string, current int, membershipCount int64, template string) error {
+	instanceGroupID := d.Id()
+	if surge := len(membershipIDs) - settings.maxUnavailable; surge > 0 {
+		err := updateInstanceGroupMembershipCount(d, meta, sess, membershipCount+int64(surge))
+		if err != nil {
+			return err
+		}
+		_, err = waitForHealthyInstanceGroupMemberships(sess, instanceGroupID, template, settings.lbID, settings.lbPoolID, current+surge, settings.healthCheckTimeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := deleteInstanceGroupMemberships(d, sess, membershipIDs, settings.lbID)
+	if err != nil {
+		return err
+	}
+	err = updateInstanceGroupMembershipCount(d, meta, sess, membershipCount)
+	if err != nil {
+		return err
+	}
+	_, err = waitForHealthyInstanceGroupMemberships(sess, instanceGroupID, template, settings.lbID, settings.lbPoolID, current+len(membershipIDs), settings.healthCheckTimeout)
+	return err
+}
+
+// rollbackInstanceGroupRollingUpdate restores oldTemplate on the instance group,
+// replaces the memberships created since in batches and waits for all the
+// memberships of oldTemplate to be healthy.
+func rollbackInstanceGroupRollingUpdate(d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1, settings instanceGroupRollingUpdateSettings, membershipCount int64, oldTemplate string) error {
+	instanceGroupID := d.Id()
+	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{
+		InstanceTemplate: &vpcv1.InstanceTemplateIdentity{
+			ID: &oldTemplate,
+		},
+		MembershipCount: &membershipCount,
+	}
+	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err)
+	}
+	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{
+		ID:                 &instanceGroupID,
+		InstanceGroupPatch: instanceGroupPatch,
+	}
+	_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating InstanceGroup: %s\n%s", err, response)
+	}
+	d.Set("instance_template", oldTemplate)
+	_, err = waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	err = replaceInstanceGroupMembershipBatches(d, meta, sess, settings, membershipCount, oldTemplate)
+	if err != nil {
+		return err
+	}
+	_, err = waitForHealthyInstanceGroupMemberships(sess, instanceGroupID, oldTemplate, settings.lbID, settings.lbPoolID, int(membershipCount), settings.healthCheckTimeout)
+	return err
+}
+
+func updateInstanceGroupMembershipCount(d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1, membershipCount int64) error {
+	instanceGroupID := d.Id()
+	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{MembershipCount: &membershipCount}
+	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err)
+	}
+	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{
+		ID:                 &instanceGroupID,
+		InstanceGroupPatch: instanceGroupPatch,
+	}
+	_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating instanceGroup's instance count to %d : %s\n%s", membershipCount, err, response)
+	}
+	_, err = waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
+	return err
+}
+
+func deleteInstanceGroupMemberships(d *schema.ResourceData, sess *vpcv1.VpcV1, membershipIDs []string, lbID string) error {
+	instanceGroupID := d.Id()
+	for _, membershipID := range membershipIDs {
+		// pool members can only be removed while the load balancer is active
+		if lbID != "" {
+			_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+		}
+		deleteInstanceGroupMembershipOptions := vpcv1.DeleteInstanceGroupMembershipOptions{
+			InstanceGroupID: &instanceGroupID,
+			ID:              &membershipID,
+		}
+		response, err := sess.DeleteInstanceGroupMembership(&deleteInstanceGroupMembershipOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error Deleting the InstanceGroup Membership: %s\n%s", err, response)
+		}
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{DELETING},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			memberships, err := listInstanceGroupMemberships(sess, instanceGroupID)
+			if err != nil {
+				return nil, DELETING, err
+			}
+			for _, membership := range memberships {
+				for _, membershipID := range membershipIDs {
+					if *membership.ID == membershipID {
+						return memberships, DELETING, nil
+					}
+				}
+			}
+			return memberships, "done", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := deleteStateConf.WaitForState()
+	return err
+}
+
+// waitForHealthyInstanceGroupMemberships waits for expected memberships created from
+// template to be healthy and, when the group is attached to a load balancer pool,
+// for their pool members to pass the health check.
+func waitForHealthyInstanceGroupMemberships(sess *vpcv1.VpcV1, instanceGroupID, template, lbID, lbPoolID string, expected int, timeout time.Duration) (interface{}, error) {
+	healthStateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.InstanceGroupMembershipStatusPendingConst},
+		Target:  []string{vpcv1.InstanceGroupMembershipStatusHealthyConst},
+		Refresh: func() (interface{}, string, error) {
+			memberships, err := listInstanceGroupMemberships(sess, instanceGroupID)
+			if err != nil {
+				return nil, vpcv1.InstanceGroupMembershipStatusPendingConst, err
+			}
+			healthy := 0
+			for _, membership := range memberships {
+				if *membership.InstanceTemplate.ID != template || *membership.Status == vpcv1.InstanceGroupMembershipStatusDeletingConst {
+					continue
+				}
+				if *membership.Status == vpcv1.InstanceGroupMembershipStatusFailedConst {
+					return membership, *membership.Status, fmt.Errorf("[ERROR] Instance group membership (%s) failed", *membership.ID)
+				}
+				if *membership.Status != vpcv1.InstanceGroupMembershipStatusHealthyConst {
+					continue
+				}
+				if lbPoolID != "" {
+					if membership.PoolMember == nil {
+						continue
+					}
+					getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
+						LoadBalancerID: &lbID,
+						PoolID:         &lbPoolID,
+						ID:             membership.PoolMember.ID,
+					}
+					lbPoolMem, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
+					if err != nil || lbPoolMem == nil {
+						return nil, vpcv1.InstanceGroupMembershipStatusPendingConst, fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member: %s\n%s", err, response)
+					}
+					if *lbPoolMem.Health != vpcv1.LoadBalancerPoolMemberHealthOkConst {
+						log.Printf("[DEBUG] Load balancer pool member (%s) health: %s", *lbPoolMem.ID, *lbPoolMem.Health)
+						continue
+					}
+				}
+				healthy++
+			}
+			if healthy < expected {
+				log.Printf("[DEBUG] %d of %d memberships of instance group (%s) with instance template (%s) are healthy", healthy, expected, instanceGroupID, template)
+				return memberships, vpcv1.InstanceGroupMembershipStatusPendingConst, nil
+			}
+			return memberships, vpcv1.InstanceGroupMembershipStatusHealthyConst, nil
+		},
+		Timeout:      timeout,
+		Delay:        20 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+
+	return healthStateConf.WaitForState()
+}
+
+func listInstanceGroupMemberships(sess *vpcv1.VpcV1, instanceGroupID string) ([]vpcv1.InstanceGroupMembership, error) {
+	start := ""
+	allrecs := []vpcv1.InstanceGroupMembership{}
+	for {
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupMembershipsOptions.Start = &start
+		}
+		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
+		}
+		start = flex.GetNext(instanceGroupMembershipCollection.Next)
+		allrecs = append(allrecs, instanceGroupMembershipCollection.Memberships...)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
@@ -109,6 +109,70 @@ func TestAccIBMISInstanceGroup_basic_loadbalancer(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceGroup_rollingUpdate(t *testing.T) {
+	randInt := acctest.RandIntRange(10, 100)
+	instanceGroupName := fmt.Sprintf("testinstancegroup%d", randInt)
+	publicKey := strings.TrimSpace(`
+	ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDVtuCfWKVGKaRmaRG6JQZY8YdxnDgGzVOK93IrV9R5Hl0JP1oiLLWlZQS2reAKb8lBqyDVEREpaoRUDjqDqXG8J/kR42FKN51su914pjSBc86wJ02VtT1Wm1zRbSg67kT+g8/T1jCgB5XBODqbcICHVP8Z1lXkgbiHLwlUrbz6OZkGJHo/M/kD1Eme8lctceIYNz/Ilm7ewMXZA4fsidpto9AjyarrJLufrOBl4MRVcZTDSJ7rLP982aHpu9pi5eJAjOZc7Og7n4ns3NFppiCwgVMCVUQbN5GBlWhZ1OsT84ZiTf+Zy8ew+Yg5T7Il8HuC7loWnz+esQPf0s3xhC/kTsGgZreIDoh/rxJfD67wKXetNSh5RH/n5BqjaOuXPFeNXmMhKlhj9nJ8scayx/wsvOGuocEIkbyJSLj3sLUU403OafgatEdnJOwbqg6rUNNF5RIjpJpL7eEWlKIi1j9LyhmPJ+fEO7TmOES82VpCMHpLbe4gf/MhhJ/Xy8DKh9s= root@ffd8363b1226
+	`)
+	vpcName := fmt.Sprintf("testvpc%d", randInt)
+	subnetName := fmt.Sprintf("testsubnet%d", randInt)
+	templateName := fmt.Sprintf("testtemplate%d", randInt)
+	sshKeyName := fmt.Sprintf("testsshkey%d", randInt)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceGroupRollingUpdateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, "instancetemplate1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_group.instance_group", "instance_template", "ibm_is_instance_template.instancetemplate1", "id"),
+					testAccCheckIBMISInstanceGroupMembershipTemplates("ibm_is_instance_group.instance_group", "ibm_is_instance_template.instancetemplate1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISInstanceGroupRollingUpdateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, "instancetemplate2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance_group.instance_group", "instance_template", "ibm_is_instance_template.instancetemplate2", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance_group.instance_group", "instance_count", "2"),
+					testAccCheckIBMISInstanceGroupMembershipTemplates("ibm_is_instance_group.instance_group", "ibm_is_instance_template.instancetemplate2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceGroupMembershipTemplates(n, template string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		tmpl, ok := s.RootModule().Resources[template]
+		if !ok {
+			return fmt.Errorf("Not found: %s", template)
+		}
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &rs.Primary.ID,
+		}
+		memberships, _, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+		if err != nil {
+			return err
+		}
+		for _, membership := range memberships.Memberships {
+			if *membership.InstanceTemplate.ID != tmpl.Primary.ID {
+				return fmt.Errorf("membership %s uses instance template %s, expected %s", *membership.ID, *membership.InstanceTemplate.ID, tmpl.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMISInstanceGroupDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -229,3 +293,67 @@ func testAccCheckIBMISInstanceGroupConfig(vpcName, subnetName, sshKeyName, publi
 	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, instanceGroupName)
 
 }
+
+func testAccCheckIBMISInstanceGroupRollingUpdateConfig(vpcName, subnetName, sshKeyName, publicKey, templateName, instanceGroupName, template string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "vpc2" {
+	  name = "%s"
+	}
+
+	resource "ibm_is_subnet" "subnet2" {
+	  name            = "%s"
+	  vpc             = ibm_is_vpc.vpc2.id
+	  zone            = "us-south-2"
+	  ipv4_cidr_block = "10.240.64.0/28"
+	}
+
+	resource "ibm_is_ssh_key" "sshkey" {
+	  name       = "%s"
+	  public_key = "%s"
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate1" {
+	  name    = "%s-1"
+	  image   = "%s"
+	  profile = "bx2-8x32"
+
+	  primary_network_interface {
+	    subnet = ibm_is_subnet.subnet2.id
+	  }
+
+	  vpc  = ibm_is_vpc.vpc2.id
+	  zone = "us-south-2"
+	  keys = [ibm_is_ssh_key.sshkey.id]
+	}
+
+	resource "ibm_is_instance_template" "instancetemplate2" {
+	  name    = "%s-2"
+	  image   = "%s"
+	  profile = "bx2-2x8"
+
+	  primary_network_interface {
+	    subnet = ibm_is_subnet.subnet2.id
+	  }
+
+	  vpc  = ibm_is_vpc.vpc2.id
+	  zone = "us-south-2"
+	  keys = [ibm_is_ssh_key.sshkey.id]
+	}
+
+	resource "ibm_is_instance_group" "instance_group" {
+	  name              = "%s"
+	  instance_template = ibm_is_instance_template.%s.id
+	  instance_count    = 2
+	  subnets           = [ibm_is_subnet.subnet2.id]
+
+	  rolling_update {
+	    batch_size      = 1
+	    max_unavailable = 0
+	  }
+
+	  timeouts {
+	    update = "30m"
+	  }
+	}
+	`, vpcName, subnetName, sshKeyName, publicKey, templateName, acc.IsImage, templateName, acc.IsImage, instanceGroupName, template)
+}
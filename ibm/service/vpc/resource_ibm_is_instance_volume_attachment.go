@@ -421,7 +421,7 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 		oldList, newList := d.GetChange(isInstanceVolAttTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *volAttRef.Volume.CRN, "", isInstanceUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource instance volume attachment (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -534,7 +534,7 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			oldList, newList := d.GetChange(isInstanceTags)
 			err = flex.UpdateTagsUsingCRN(oldList, newList, meta, volumecrn)
 			if err != nil {
-				log.Printf(
+				flex.AddWarningf(d,
 					"Error on update of resource Instance volume attachment (%s) tags: %s", d.Id(), err)
 			}
 		}
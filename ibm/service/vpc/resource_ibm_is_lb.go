@@ -507,7 +507,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		oldList, newList := d.GetChange(isLBTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -516,7 +516,7 @@ func lbCreate(d *schema.ResourceData, meta interface{}, name, lbType, rg string,
 		oldList, newList := d.GetChange(isLBAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource load balancer (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -748,7 +748,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 			oldList, newList := d.GetChange(isLBTags)
 			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isUserTagType)
 			if err != nil {
-				log.Printf(
+				flex.AddWarningf(d,
 					"Error on update of resource vpc Load Balancer (%s) tags: %s", d.Id(), err)
 			}
 		}
@@ -756,7 +756,7 @@ func lbUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 			oldList, newList := d.GetChange(isLBAccessTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *lb.CRN, "", isAccessTagType)
 			if err != nil {
-				log.Printf(
+				flex.AddWarningf(d,
 					"Error on update of resource load balancer (%s) access tags: %s", d.Id(), err)
 			}
 		}
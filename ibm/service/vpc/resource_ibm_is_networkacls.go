@@ -145,140 +145,144 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Optional: true,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						isNetworkACLRuleID: {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						isNetworkACLRuleName: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleName),
-						},
-						isNetworkACLRuleAction: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleAction),
-						},
-						isNetworkACLRuleIPVersion: {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						isNetworkACLRuleSource: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSource),
-						},
-						isNetworkACLRuleDestination: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleDestination),
-						},
-						isNetworkACLRuleDirection: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     false,
-							Description:  "Direction of traffic to enforce, either inbound or outbound",
-							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleDirection),
-						},
-						isNetworkACLSubnets: {
-							Type:     schema.TypeInt,
-							Computed: true,
-						},
-						isNetworkACLRuleICMP: {
-							Type:     schema.TypeList,
-							MinItems: 0,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									isNetworkACLRuleICMPCode: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPCode),
-									},
-									isNetworkACLRuleICMPType: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPType),
-									},
-								},
-							},
-						},
-
-						isNetworkACLRuleTCP: {
-							Type:     schema.TypeList,
-							MinItems: 0,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									isNetworkACLRulePortMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      65535,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
-									},
-									isNetworkACLRulePortMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
-									},
-									isNetworkACLRuleSourcePortMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      65535,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
-									},
-									isNetworkACLRuleSourcePortMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
-									},
-								},
-							},
-						},
-
-						isNetworkACLRuleUDP: {
-							Type:     schema.TypeList,
-							MinItems: 0,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									isNetworkACLRulePortMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      65535,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
-									},
-									isNetworkACLRulePortMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
-									},
-									isNetworkACLRuleSourcePortMax: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      65535,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
-									},
-									isNetworkACLRuleSourcePortMin: {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1,
-										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
-									},
-								},
-							},
-						},
+					Schema: makeIBMISNetworkACLInlineRuleSchema(),
+				},
+			},
+		},
+	}
+}
+
+func makeIBMISNetworkACLInlineRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		isNetworkACLRuleID: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		isNetworkACLRuleName: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleName),
+		},
+		isNetworkACLRuleAction: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleAction),
+		},
+		isNetworkACLRuleIPVersion: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		isNetworkACLRuleSource: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSource),
+		},
+		isNetworkACLRuleDestination: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleDestination),
+		},
+		isNetworkACLRuleDirection: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			Description:  "Direction of traffic to enforce, either inbound or outbound",
+			ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleDirection),
+		},
+		isNetworkACLSubnets: {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		isNetworkACLRuleICMP: {
+			Type:     schema.TypeList,
+			MinItems: 0,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isNetworkACLRuleICMPCode: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPCode),
+					},
+					isNetworkACLRuleICMPType: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleICMPType),
+					},
+				},
+			},
+		},
+
+		isNetworkACLRuleTCP: {
+			Type:     schema.TypeList,
+			MinItems: 0,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isNetworkACLRulePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
+					},
+					isNetworkACLRulePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
+					},
+					isNetworkACLRuleSourcePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
+					},
+					isNetworkACLRuleSourcePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
+					},
+				},
+			},
+		},
+
+		isNetworkACLRuleUDP: {
+			Type:     schema.TypeList,
+			MinItems: 0,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isNetworkACLRulePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMax),
+					},
+					isNetworkACLRulePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRulePortMin),
+					},
+					isNetworkACLRuleSourcePortMax: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      65535,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMax),
+					},
+					isNetworkACLRuleSourcePortMin: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleSourcePortMin),
 					},
 				},
 			},
@@ -476,7 +480,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource network acl (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -484,7 +488,7 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 		oldList, newList := d.GetChange(isNetworkACLAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *nwacl.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource network acl (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -537,87 +541,7 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	d.Set(isNetworkACLTags, tags)
 	d.Set(isNetworkACLAccessTags, accesstags)
 	d.Set(isNetworkACLCRN, *nwacl.CRN)
-	rules := make([]interface{}, 0)
-	if len(nwacl.Rules) > 0 {
-		for _, rulex := range nwacl.Rules {
-			log.Println("[DEBUG] Type of the Rule", reflect.TypeOf(rulex))
-			rule := make(map[string]interface{})
-			rule[isNetworkACLSubnets] = len(nwacl.Subnets)
-			switch reflect.TypeOf(rulex).String() {
-			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp":
-				{
-					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp)
-					rule[isNetworkACLRuleID] = *rulex.ID
-					rule[isNetworkACLRuleName] = *rulex.Name
-					rule[isNetworkACLRuleAction] = *rulex.Action
-					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
-					rule[isNetworkACLRuleSource] = *rulex.Source
-					rule[isNetworkACLRuleDestination] = *rulex.Destination
-					rule[isNetworkACLRuleDirection] = *rulex.Direction
-					rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
-					rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
-					icmp := make([]map[string]int, 1, 1)
-					if rulex.Code != nil && rulex.Type != nil {
-						icmp[0] = map[string]int{
-							isNetworkACLRuleICMPCode: int(*rulex.Code),
-							isNetworkACLRuleICMPType: int(*rulex.Type),
-						}
-					}
-					rule[isNetworkACLRuleICMP] = icmp
-				}
-			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp":
-				{
-					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp)
-					rule[isNetworkACLRuleID] = *rulex.ID
-					rule[isNetworkACLRuleName] = *rulex.Name
-					rule[isNetworkACLRuleAction] = *rulex.Action
-					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
-					rule[isNetworkACLRuleSource] = *rulex.Source
-					rule[isNetworkACLRuleDestination] = *rulex.Destination
-					rule[isNetworkACLRuleDirection] = *rulex.Direction
-					if *rulex.Protocol == "tcp" {
-						rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
-						rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
-						tcp := make([]map[string]int, 1, 1)
-						tcp[0] = map[string]int{
-							isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rulex.SourcePortMax),
-							isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rulex.SourcePortMin),
-						}
-						tcp[0][isNetworkACLRulePortMax] = checkNetworkACLNil(rulex.DestinationPortMax)
-						tcp[0][isNetworkACLRulePortMin] = checkNetworkACLNil(rulex.DestinationPortMin)
-						rule[isNetworkACLRuleTCP] = tcp
-					} else if *rulex.Protocol == "udp" {
-						rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
-						rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
-						udp := make([]map[string]int, 1, 1)
-						udp[0] = map[string]int{
-							isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rulex.SourcePortMax),
-							isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rulex.SourcePortMin),
-						}
-						udp[0][isNetworkACLRulePortMax] = checkNetworkACLNil(rulex.DestinationPortMax)
-						udp[0][isNetworkACLRulePortMin] = checkNetworkACLNil(rulex.DestinationPortMin)
-						rule[isNetworkACLRuleUDP] = udp
-					}
-				}
-			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll":
-				{
-					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
-					rule[isNetworkACLRuleID] = *rulex.ID
-					rule[isNetworkACLRuleName] = *rulex.Name
-					rule[isNetworkACLRuleAction] = *rulex.Action
-					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
-					rule[isNetworkACLRuleSource] = *rulex.Source
-					rule[isNetworkACLRuleDestination] = *rulex.Destination
-					rule[isNetworkACLRuleDirection] = *rulex.Direction
-					rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
-					rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
-					rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
-				}
-			}
-			rules = append(rules, rule)
-		}
-	}
-	d.Set(isNetworkACLRules, rules)
+	d.Set(isNetworkACLRules, flattenNetworkACLRules(nwacl.Rules, len(nwacl.Subnets)))
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -673,7 +597,7 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		oldList, newList := d.GetChange(isNetworkACLTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource network acl (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -681,7 +605,7 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		oldList, newList := d.GetChange(isNetworkACLAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isNetworkACLCRN).(string), "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource network acl (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -769,6 +693,92 @@ func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, err
 	return true, nil
 }
 
+// flattenNetworkACLRules returns the inline rules of the rules of a network
+// ACL attached to the given number of subnets.
+func flattenNetworkACLRules(nwaclRules []vpcv1.NetworkACLRuleItemIntf, subnets int) []interface{} {
+	rules := make([]interface{}, 0)
+	if len(nwaclRules) > 0 {
+		for _, rulex := range nwaclRules {
+			log.Println("[DEBUG] Type of the Rule", reflect.TypeOf(rulex))
+			rule := make(map[string]interface{})
+			rule[isNetworkACLSubnets] = subnets
+			switch reflect.TypeOf(rulex).String() {
+			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp":
+				{
+					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp)
+					rule[isNetworkACLRuleID] = *rulex.ID
+					rule[isNetworkACLRuleName] = *rulex.Name
+					rule[isNetworkACLRuleAction] = *rulex.Action
+					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
+					rule[isNetworkACLRuleSource] = *rulex.Source
+					rule[isNetworkACLRuleDestination] = *rulex.Destination
+					rule[isNetworkACLRuleDirection] = *rulex.Direction
+					rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
+					rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
+					icmp := make([]map[string]int, 1, 1)
+					if rulex.Code != nil && rulex.Type != nil {
+						icmp[0] = map[string]int{
+							isNetworkACLRuleICMPCode: int(*rulex.Code),
+							isNetworkACLRuleICMPType: int(*rulex.Type),
+						}
+					}
+					rule[isNetworkACLRuleICMP] = icmp
+				}
+			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp":
+				{
+					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp)
+					rule[isNetworkACLRuleID] = *rulex.ID
+					rule[isNetworkACLRuleName] = *rulex.Name
+					rule[isNetworkACLRuleAction] = *rulex.Action
+					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
+					rule[isNetworkACLRuleSource] = *rulex.Source
+					rule[isNetworkACLRuleDestination] = *rulex.Destination
+					rule[isNetworkACLRuleDirection] = *rulex.Direction
+					if *rulex.Protocol == "tcp" {
+						rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
+						rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
+						tcp := make([]map[string]int, 1, 1)
+						tcp[0] = map[string]int{
+							isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rulex.SourcePortMax),
+							isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rulex.SourcePortMin),
+						}
+						tcp[0][isNetworkACLRulePortMax] = checkNetworkACLNil(rulex.DestinationPortMax)
+						tcp[0][isNetworkACLRulePortMin] = checkNetworkACLNil(rulex.DestinationPortMin)
+						rule[isNetworkACLRuleTCP] = tcp
+					} else if *rulex.Protocol == "udp" {
+						rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
+						rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
+						udp := make([]map[string]int, 1, 1)
+						udp[0] = map[string]int{
+							isNetworkACLRuleSourcePortMax: checkNetworkACLNil(rulex.SourcePortMax),
+							isNetworkACLRuleSourcePortMin: checkNetworkACLNil(rulex.SourcePortMin),
+						}
+						udp[0][isNetworkACLRulePortMax] = checkNetworkACLNil(rulex.DestinationPortMax)
+						udp[0][isNetworkACLRulePortMin] = checkNetworkACLNil(rulex.DestinationPortMin)
+						rule[isNetworkACLRuleUDP] = udp
+					}
+				}
+			case "*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll":
+				{
+					rulex := rulex.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
+					rule[isNetworkACLRuleID] = *rulex.ID
+					rule[isNetworkACLRuleName] = *rulex.Name
+					rule[isNetworkACLRuleAction] = *rulex.Action
+					rule[isNetworkACLRuleIPVersion] = *rulex.IPVersion
+					rule[isNetworkACLRuleSource] = *rulex.Source
+					rule[isNetworkACLRuleDestination] = *rulex.Destination
+					rule[isNetworkACLRuleDirection] = *rulex.Direction
+					rule[isNetworkACLRuleICMP] = make([]map[string]int, 0, 0)
+					rule[isNetworkACLRuleTCP] = make([]map[string]int, 0, 0)
+					rule[isNetworkACLRuleUDP] = make([]map[string]int, 0, 0)
+				}
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
 func checkNetworkACLNil(ptr *int64) int {
 	if ptr == nil {
 		return 0
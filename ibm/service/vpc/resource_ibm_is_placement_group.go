@@ -313,7 +313,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 		oldList, newList := d.GetChange(isPlacementGroupTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource subnet (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -322,7 +322,7 @@ func resourceIbmIsPlacementGroupUpdate(context context.Context, d *schema.Resour
 		oldList, newList := d.GetChange(isPlacementGroupAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource subnet (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -258,7 +258,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of vpc public gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -267,7 +267,7 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isPublicGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of vpc public gateway (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -393,7 +393,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource Public Gateway (%s) tags: %s", id, err)
 		}
 	}
@@ -409,7 +409,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isPublicGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *publicgw.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource Public Gateway (%s) access tags: %s", d.Id(), err)
 		}
 	}
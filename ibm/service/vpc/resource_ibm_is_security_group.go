@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -23,6 +24,7 @@ const (
 	isSecurityGroupName          = "name"
 	isSecurityGroupVPC           = "vpc"
 	isSecurityGroupRules         = "rules"
+	isSecurityGroupRule          = "rule"
 	isSecurityGroupResourceGroup = "resource_group"
 	isSecurityGroupTags          = "tags"
 	isSecurityGroupAccessTags    = "access_tags"
@@ -49,6 +51,10 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return validateSecurityGroupInlineRules(diff.Get(isSecurityGroupRule).(*schema.Set))
+				}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -105,6 +111,16 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 				},
 			},
 
+			isSecurityGroupRule: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMISSecurityGroupInlineRuleHash,
+				Description: "The rules of the security group. When set, the rules are managed authoritatively: the rules of the security group that are not configured are deleted.",
+				Elem: &schema.Resource{
+					Schema: makeIBMISSecurityGroupInlineRuleSchema(),
+				},
+			},
+
 			isSecurityGroupResourceGroup: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -210,7 +226,7 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error while creating Security Group tags : %s\n%s", *sg.ID, err)
 		}
 	}
@@ -218,10 +234,16 @@ func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isSecurityGroupAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *sg.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of Security Group (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	if rules := d.Get(isSecurityGroupRule).(*schema.Set); rules.Len() > 0 {
+		err = reconcileSecurityGroupRules(sess, *sg.ID, rules)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMISSecurityGroupRead(d, meta)
 }
 
@@ -377,6 +399,21 @@ func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 	d.Set(isSecurityGroupRules, rules)
+	// The rules are only refreshed when they are managed, so that the rules
+	// added outside of Terraform show up as deleted by the next plan
+	if d.Get(isSecurityGroupRule).(*schema.Set).Len() > 0 {
+		inlineRules := make([]interface{}, 0, len(group.Rules))
+		for _, rule := range group.Rules {
+			_, inlineRule, err := flattenSecurityGroupInlineRule(rule)
+			if err != nil {
+				return err
+			}
+			inlineRules = append(inlineRules, inlineRule)
+		}
+		if err = d.Set(isSecurityGroupRule, schema.NewSet(resourceIBMISSecurityGroupInlineRuleHash, inlineRules)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting Security Group rule: %s", err)
+		}
+	}
 	d.SetId(*group.ID)
 	if group.ResourceGroup != nil {
 		d.Set(isSecurityGroupResourceGroup, group.ResourceGroup.ID)
@@ -405,7 +442,7 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isSecurityGroupTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error Updating Security Group tags: %s\n%s", d.Id(), err)
 		}
 	}
@@ -413,10 +450,17 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		oldList, newList := d.GetChange(isSecurityGroupAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSecurityGroupCRN).(string), "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of Security Group (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	// Removing all the rule blocks deletes all the rules
+	if d.HasChange(isSecurityGroupRule) {
+		err = reconcileSecurityGroupRules(sess, id, d.Get(isSecurityGroupRule).(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
 	if d.HasChange(isSecurityGroupName) {
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
@@ -611,6 +655,359 @@ func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 	}
 }
 
+func makeIBMISSecurityGroupInlineRuleSchema() map[string]*schema.Schema {
+	ports := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupRulePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+			},
+			isSecurityGroupRulePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+			},
+		},
+	}
+	return map[string]*schema.Schema{
+
+		isSecurityGroupRuleDirection: {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Direction of traffic to enforce, either inbound or outbound",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+		},
+
+		isSecurityGroupRuleIPVersion: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      isSecurityGroupRuleIPVersionDefault,
+			Description:  "IP version: ipv4",
+			ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+		},
+
+		isSecurityGroupRuleRemote: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "0.0.0.0/0",
+			Description: "Security group id: an IP address, a CIDR block, or a single security group identifier",
+		},
+
+		isSecurityGroupRuleLocal: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "0.0.0.0/0",
+			Description: "Security group local ip: an IP address, a CIDR block",
+		},
+
+		isSecurityGroupRuleProtocolICMP: {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "protocol=icmp",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					// An unset type or code, which allows all of them, is
+					// securityGroupRuleICMPAny, so that 0 can be told apart
+					isSecurityGroupRuleType: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      securityGroupRuleICMPAny,
+						ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+					},
+					isSecurityGroupRuleCode: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      securityGroupRuleICMPAny,
+						ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+					},
+				},
+			},
+		},
+
+		isSecurityGroupRuleProtocolTCP: {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "protocol=tcp",
+			Elem:        ports,
+		},
+
+		isSecurityGroupRuleProtocolUDP: {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "protocol=udp",
+			Elem:        ports,
+		},
+	}
+}
+
+// securityGroupRuleICMPAny is the ICMP type or code of an inline rule that
+// allows all the types or codes.
+const securityGroupRuleICMPAny = -1
+
+// securityGroupInlineRuleProtocol returns the protocol of an inline rule, and
+// its ICMP type and code or its port range. An unset ICMP type or code is
+// securityGroupRuleICMPAny.
+func securityGroupInlineRuleProtocol(rule map[string]interface{}) (protocol string, min, max int64) {
+	protocol = "all"
+	for _, prot := range []string{isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		blocks, ok := rule[prot].([]interface{})
+		if !ok || len(blocks) == 0 {
+			continue
+		}
+		protocol = prot
+		block, _ := blocks[0].(map[string]interface{})
+		if prot == isSecurityGroupRuleProtocolICMP {
+			min, max = securityGroupRuleICMPAny, securityGroupRuleICMPAny
+			if block != nil {
+				min, max = int64(block[isSecurityGroupRuleType].(int)), int64(block[isSecurityGroupRuleCode].(int))
+			}
+		} else {
+			min, max = 1, 65535
+			if block != nil {
+				min, max = int64(block[isSecurityGroupRulePortMin].(int)), int64(block[isSecurityGroupRulePortMax].(int))
+			}
+		}
+	}
+	return
+}
+
+// securityGroupInlineRuleKey returns the canonical form of an inline rule, by
+// which the configured rules and the rules of the security group are matched.
+func securityGroupInlineRuleKey(rule map[string]interface{}) string {
+	protocol, min, max := securityGroupInlineRuleProtocol(rule)
+	return fmt.Sprintf("%s-%s-%s-%s-%s-%d-%d", rule[isSecurityGroupRuleDirection], rule[isSecurityGroupRuleIPVersion],
+		rule[isSecurityGroupRuleRemote], rule[isSecurityGroupRuleLocal], protocol, min, max)
+}
+
+func resourceIBMISSecurityGroupInlineRuleHash(v interface{}) int {
+	return schema.HashString(securityGroupInlineRuleKey(v.(map[string]interface{})))
+}
+
+func validateSecurityGroupInlineRules(rules *schema.Set) error {
+	for _, r := range rules.List() {
+		rule := r.(map[string]interface{})
+		protocols := 0
+		for _, prot := range []string{isSecurityGroupRuleProtocolICMP, isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+			if blocks, ok := rule[prot].([]interface{}); ok && len(blocks) > 0 {
+				protocols++
+			}
+		}
+		if protocols > 1 {
+			return fmt.Errorf("[ERROR] A security group rule has at most one of icmp, tcp and udp")
+		}
+		if protocol, icmpType, icmpCode := securityGroupInlineRuleProtocol(rule); protocol == isSecurityGroupRuleProtocolICMP && icmpCode != securityGroupRuleICMPAny && icmpType == securityGroupRuleICMPAny {
+			return fmt.Errorf("icmp code requires icmp type")
+		}
+	}
+	return nil
+}
+
+// flattenSecurityGroupInlineRule returns the ID and the inline rule of a rule
+// of a security group.
+func flattenSecurityGroupInlineRule(rule vpcv1.SecurityGroupRuleIntf) (string, map[string]interface{}, error) {
+	var id, direction, ipVersion, protocol *string
+	var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+	var localIntf vpcv1.SecurityGroupRuleLocalIntf
+	var icmpType, icmpCode, portMin, portMax *int64
+	switch rule := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		id, direction, ipVersion, protocol, remoteIntf, localIntf = rule.ID, rule.Direction, rule.IPVersion, rule.Protocol, rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id, direction, ipVersion, protocol, remoteIntf, localIntf = rule.ID, rule.Direction, rule.IPVersion, rule.Protocol, rule.Remote, rule.Local
+		icmpType, icmpCode = rule.Type, rule.Code
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id, direction, ipVersion, protocol, remoteIntf, localIntf = rule.ID, rule.Direction, rule.IPVersion, rule.Protocol, rule.Remote, rule.Local
+		portMin, portMax = rule.PortMin, rule.PortMax
+	case *vpcv1.SecurityGroupRule:
+		id, direction, ipVersion, protocol, remoteIntf, localIntf = rule.ID, rule.Direction, rule.IPVersion, rule.Protocol, rule.Remote, rule.Local
+		icmpType, icmpCode, portMin, portMax = rule.Type, rule.Code, rule.PortMin, rule.PortMax
+	default:
+		return "", nil, fmt.Errorf("[ERROR] Unknown security group rule type %T", rule)
+	}
+	if id == nil || direction == nil || ipVersion == nil || protocol == nil {
+		return "", nil, fmt.Errorf("[ERROR] Incomplete security group rule %+v", rule)
+	}
+
+	r := map[string]interface{}{
+		isSecurityGroupRuleDirection: *direction,
+		isSecurityGroupRuleIPVersion: *ipVersion,
+		isSecurityGroupRuleRemote:    securityGroupRuleRemote(remoteIntf),
+		isSecurityGroupRuleLocal:     securityGroupRuleLocal(localIntf),
+	}
+	switch *protocol {
+	case "all":
+	case isSecurityGroupRuleProtocolICMP:
+		icmp := map[string]interface{}{
+			isSecurityGroupRuleType: securityGroupRuleICMPAny,
+			isSecurityGroupRuleCode: securityGroupRuleICMPAny,
+		}
+		if icmpType != nil {
+			icmp[isSecurityGroupRuleType] = int(*icmpType)
+		}
+		if icmpCode != nil {
+			icmp[isSecurityGroupRuleCode] = int(*icmpCode)
+		}
+		r[isSecurityGroupRuleProtocolICMP] = []interface{}{icmp}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		ports := map[string]interface{}{
+			isSecurityGroupRulePortMin: 1,
+			isSecurityGroupRulePortMax: 65535,
+		}
+		if portMin != nil {
+			ports[isSecurityGroupRulePortMin] = int(*portMin)
+		}
+		if portMax != nil {
+			ports[isSecurityGroupRulePortMax] = int(*portMax)
+		}
+		r[*protocol] = []interface{}{ports}
+	default:
+		return "", nil, fmt.Errorf("[ERROR] Unknown protocol %s of security group rule (%s)", *protocol, *id)
+	}
+	return *id, r, nil
+}
+
+// securityGroupRuleRemote returns the security group ID, IP address or CIDR
+// block of the remote of a rule, or "" when it has none.
+func securityGroupRuleRemote(remoteIntf vpcv1.SecurityGroupRuleRemoteIntf) string {
+	if remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			return *remote.ID
+		} else if remote.Address != nil {
+			return *remote.Address
+		} else if remote.CIDRBlock != nil {
+			return *remote.CIDRBlock
+		}
+	}
+	return ""
+}
+
+// securityGroupRuleLocal returns the IP address or CIDR block of the local of
+// a rule, or "" when it has none.
+func securityGroupRuleLocal(localIntf vpcv1.SecurityGroupRuleLocalIntf) string {
+	if local, ok := localIntf.(*vpcv1.SecurityGroupRuleLocal); ok && local != nil {
+		if local.Address != nil {
+			return *local.Address
+		} else if local.CIDRBlock != nil {
+			return *local.CIDRBlock
+		}
+	}
+	return ""
+}
+
+// expandSecurityGroupInlineRule returns the prototype of an inline rule.
+func expandSecurityGroupInlineRule(rule map[string]interface{}) *vpcv1.SecurityGroupRulePrototype {
+	direction := rule[isSecurityGroupRuleDirection].(string)
+	ipVersion := rule[isSecurityGroupRuleIPVersion].(string)
+	protocol, min, max := securityGroupInlineRuleProtocol(rule)
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &direction,
+		IPVersion: &ipVersion,
+		Protocol:  &protocol,
+	}
+	switch protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		if min != securityGroupRuleICMPAny {
+			prototype.Type = &min
+		}
+		if max != securityGroupRuleICMPAny {
+			prototype.Code = &max
+		}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		prototype.PortMin = &min
+		prototype.PortMax = &max
+	}
+	if remote := rule[isSecurityGroupRuleRemote].(string); remote != "" {
+		address, cidr, id, _ := inferRemoteSecurityGroup(remote)
+		prototype.Remote = &vpcv1.SecurityGroupRuleRemotePrototype{}
+		if address != "" {
+			prototype.Remote.(*vpcv1.SecurityGroupRuleRemotePrototype).Address = &address
+		} else if cidr != "" {
+			prototype.Remote.(*vpcv1.SecurityGroupRuleRemotePrototype).CIDRBlock = &cidr
+		} else {
+			prototype.Remote.(*vpcv1.SecurityGroupRuleRemotePrototype).ID = &id
+		}
+	}
+	if local := rule[isSecurityGroupRuleLocal].(string); local != "" {
+		address, cidr, _ := inferLocalSecurityGroup(local)
+		prototype.Local = &vpcv1.SecurityGroupRuleLocalPrototype{}
+		if address != "" {
+			prototype.Local.(*vpcv1.SecurityGroupRuleLocalPrototype).Address = &address
+		} else if cidr != "" {
+			prototype.Local.(*vpcv1.SecurityGroupRuleLocalPrototype).CIDRBlock = &cidr
+		}
+	}
+	return prototype
+}
+
+// reconcileSecurityGroupRules makes the rules of the security group match the
+// rule set. The missing rules are created before the other rules are deleted,
+// so that the traffic allowed by both is never interrupted.
+func reconcileSecurityGroupRules(sess *vpcv1.VpcV1, securityGroupID string, rules *schema.Set) error {
+	isSecurityGroupRuleKey := "security_group_rule_key_" + securityGroupID
+	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
+	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &securityGroupID,
+	}
+	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+	}
+
+	wanted := make(map[string]map[string]interface{}, rules.Len())
+	for _, r := range rules.List() {
+		rule := r.(map[string]interface{})
+		wanted[securityGroupInlineRuleKey(rule)] = rule
+	}
+	existing := make(map[string]bool, len(group.Rules))
+	var obsolete []string
+	for _, groupRule := range group.Rules {
+		ruleID, rule, err := flattenSecurityGroupInlineRule(groupRule)
+		if err != nil {
+			return err
+		}
+		key := securityGroupInlineRuleKey(rule)
+		if _, ok := wanted[key]; ok && !existing[key] {
+			existing[key] = true
+		} else {
+			obsolete = append(obsolete, ruleID)
+		}
+	}
+
+	for key, rule := range wanted {
+		if existing[key] {
+			continue
+		}
+		createSecurityGroupRuleOptions := &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &securityGroupID,
+			SecurityGroupRulePrototype: expandSecurityGroupInlineRule(rule),
+		}
+		_, response, err := sess.CreateSecurityGroupRule(createSecurityGroupRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while creating Security Group Rule %s\n%s", err, response)
+		}
+	}
+	for _, ruleID := range obsolete {
+		ruleID := ruleID
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &securityGroupID,
+			ID:              &ruleID,
+		}
+		response, err := sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response)
+		}
+	}
+	return nil
+}
+
 func isWaitForTargetDeleted(client *vpcv1.VpcV1, sgId, targetId string, target vpcv1.SecurityGroupTargetReferenceIntf, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Security group(%s) target(%s) to be deleted.", sgId, targetId)
 
@@ -53,6 +53,62 @@ func TestAccIBMISSecurityGroup_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISSecurityGroup_inlineRules(t *testing.T) {
+	var securityGroup string
+
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsg-rules-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 22),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupExists("ibm_is_security_group.testacc_security_group", securityGroup),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rule.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 2222),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						securityGroup = s.RootModule().Resources["ibm_is_security_group.testacc_security_group"].Primary.ID
+						return nil
+					},
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_security_group.testacc_security_group", "rule.*", map[string]string{
+							"direction":      "inbound",
+							"tcp.0.port_min": "2222",
+							"tcp.0.port_max": "2222",
+						}),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "3"),
+				),
+			},
+			{
+				// A rule added outside of Terraform shows up in the plan, and is deleted
+				PreConfig:          testAccIBMISSecurityGroupAddRule(&securityGroup),
+				Config:             testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 2222),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name, 2222),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "rules.#", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISSecurityGroup_wait(t *testing.T) {
 	var securityGroup string
 
@@ -227,3 +283,54 @@ resource "ibm_is_security_group" "testacc_security_group" {
 }`, vpcname, name)
 
 }
+
+func testAccIBMISSecurityGroupAddRule(securityGroupID *string) func() {
+	return func() {
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		direction, protocol := "inbound", "udp"
+		portMin, portMax := int64(53), int64(53)
+		_, response, err := sess.CreateSecurityGroupRule(&vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID: securityGroupID,
+			SecurityGroupRulePrototype: &vpcv1.SecurityGroupRulePrototype{
+				Direction: &direction,
+				Protocol:  &protocol,
+				PortMin:   &portMin,
+				PortMax:   &portMax,
+			},
+		})
+		if err != nil {
+			panic(fmt.Sprintf("Error creating Security Group Rule: %s\n%s", err, response))
+		}
+	}
+}
+
+func testAccCheckIBMISsecurityGroupInlineRulesConfig(vpcname, name string, sshPort int) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group" {
+	name = "%s"
+	vpc  = ibm_is_vpc.testacc_vpc.id
+
+	rule {
+		direction = "inbound"
+		remote    = "10.0.0.0/8"
+		tcp {
+			port_min = %[3]d
+			port_max = %[3]d
+		}
+	}
+	rule {
+		direction = "inbound"
+		icmp {
+			type = 8
+		}
+	}
+	rule {
+		direction = "outbound"
+	}
+}`, vpcname, name, sshPort)
+
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// inlineRule returns an inline rule as read from the configuration, with the
+// defaults of the schema.
+func inlineRule(direction, remote string, protocol string, block map[string]interface{}) map[string]interface{} {
+	rule := map[string]interface{}{
+		isSecurityGroupRuleDirection:    direction,
+		isSecurityGroupRuleIPVersion:    "ipv4",
+		isSecurityGroupRuleRemote:       remote,
+		isSecurityGroupRuleLocal:        "0.0.0.0/0",
+		isSecurityGroupRuleProtocolICMP: []interface{}{},
+		isSecurityGroupRuleProtocolTCP:  []interface{}{},
+		isSecurityGroupRuleProtocolUDP:  []interface{}{},
+	}
+	if protocol != "all" {
+		rule[protocol] = []interface{}{block}
+	}
+	return rule
+}
+
+func icmpBlock(icmpType, icmpCode int) map[string]interface{} {
+	return map[string]interface{}{isSecurityGroupRuleType: icmpType, isSecurityGroupRuleCode: icmpCode}
+}
+
+func portsBlock(min, max int) map[string]interface{} {
+	return map[string]interface{}{isSecurityGroupRulePortMin: min, isSecurityGroupRulePortMax: max}
+}
+
+func TestExpandSecurityGroupInlineRuleICMP(t *testing.T) {
+	// Type and code 0 are echo reply, not all the ICMP traffic
+	prototype := expandSecurityGroupInlineRule(inlineRule("inbound", "0.0.0.0/0", "icmp", icmpBlock(0, 0)))
+	assert.Equal(t, "icmp", *prototype.Protocol)
+	if assert.NotNil(t, prototype.Type) && assert.NotNil(t, prototype.Code) {
+		assert.Equal(t, int64(0), *prototype.Type)
+		assert.Equal(t, int64(0), *prototype.Code)
+	}
+
+	prototype = expandSecurityGroupInlineRule(inlineRule("inbound", "0.0.0.0/0", "icmp", icmpBlock(8, securityGroupRuleICMPAny)))
+	if assert.NotNil(t, prototype.Type) {
+		assert.Equal(t, int64(8), *prototype.Type)
+	}
+	assert.Nil(t, prototype.Code)
+
+	prototype = expandSecurityGroupInlineRule(inlineRule("inbound", "0.0.0.0/0", "icmp", icmpBlock(securityGroupRuleICMPAny, securityGroupRuleICMPAny)))
+	assert.Nil(t, prototype.Type)
+	assert.Nil(t, prototype.Code)
+
+	// An empty icmp block allows all the ICMP traffic
+	prototype = expandSecurityGroupInlineRule(inlineRule("inbound", "0.0.0.0/0", "icmp", nil))
+	assert.Equal(t, "icmp", *prototype.Protocol)
+	assert.Nil(t, prototype.Type)
+	assert.Nil(t, prototype.Code)
+}
+
+func TestExpandSecurityGroupInlineRulePorts(t *testing.T) {
+	for _, protocol := range []string{"tcp", "udp"} {
+		for _, ports := range [][2]int{{1, 65535}, {22, 22}, {65535, 65535}, {1, 1}} {
+			prototype := expandSecurityGroupInlineRule(inlineRule("outbound", "0.0.0.0/0", protocol, portsBlock(ports[0], ports[1])))
+			assert.Equal(t, protocol, *prototype.Protocol)
+			assert.Equal(t, int64(ports[0]), *prototype.PortMin)
+			assert.Equal(t, int64(ports[1]), *prototype.PortMax)
+			assert.Nil(t, prototype.Type)
+			assert.Nil(t, prototype.Code)
+		}
+	}
+
+	// An empty tcp block allows all the ports
+	prototype := expandSecurityGroupInlineRule(inlineRule("outbound", "0.0.0.0/0", "tcp", nil))
+	assert.Equal(t, int64(1), *prototype.PortMin)
+	assert.Equal(t, int64(65535), *prototype.PortMax)
+
+	prototype = expandSecurityGroupInlineRule(inlineRule("outbound", "0.0.0.0/0", "all", nil))
+	assert.Equal(t, "all", *prototype.Protocol)
+	assert.Nil(t, prototype.PortMin)
+	assert.Nil(t, prototype.PortMax)
+}
+
+func TestExpandSecurityGroupInlineRuleRemote(t *testing.T) {
+	remote := expandSecurityGroupInlineRule(inlineRule("inbound", "10.0.0.0/8", "all", nil)).Remote.(*vpcv1.SecurityGroupRuleRemotePrototype)
+	assert.Equal(t, "10.0.0.0/8", *remote.CIDRBlock)
+	assert.Nil(t, remote.Address)
+	assert.Nil(t, remote.ID)
+
+	remote = expandSecurityGroupInlineRule(inlineRule("inbound", "10.1.2.3", "all", nil)).Remote.(*vpcv1.SecurityGroupRuleRemotePrototype)
+	assert.Equal(t, "10.1.2.3", *remote.Address)
+	assert.Nil(t, remote.CIDRBlock)
+	assert.Nil(t, remote.ID)
+
+	remote = expandSecurityGroupInlineRule(inlineRule("inbound", "r006-4f8b9c6e-1234-4321-8765-1a2b3c4d5e6f", "all", nil)).Remote.(*vpcv1.SecurityGroupRuleRemotePrototype)
+	assert.Equal(t, "r006-4f8b9c6e-1234-4321-8765-1a2b3c4d5e6f", *remote.ID)
+	assert.Nil(t, remote.Address)
+	assert.Nil(t, remote.CIDRBlock)
+
+	assert.Nil(t, expandSecurityGroupInlineRule(inlineRule("inbound", "", "all", nil)).Remote)
+}
+
+func TestFlattenSecurityGroupInlineRule(t *testing.T) {
+	id, direction, ipVersion := "rule-id", "inbound", "ipv4"
+	remote := &vpcv1.SecurityGroupRuleRemote{CIDRBlock: stringPtr("10.0.0.0/8")}
+	local := &vpcv1.SecurityGroupRuleLocal{CIDRBlock: stringPtr("0.0.0.0/0")}
+	icmp, tcp, udp, all, gre := "icmp", "tcp", "udp", "all", "gre"
+	zero, eight, port := int64(0), int64(8), int64(443)
+
+	ruleID, rule, err := flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &icmp, Remote: remote, Local: local, Type: &zero, Code: &zero,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, id, ruleID)
+	assert.Equal(t, []interface{}{icmpBlock(0, 0)}, rule[isSecurityGroupRuleProtocolICMP])
+	assert.Equal(t, "10.0.0.0/8", rule[isSecurityGroupRuleRemote])
+	assert.Equal(t, "0.0.0.0/0", rule[isSecurityGroupRuleLocal])
+
+	_, rule, err = flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &icmp, Remote: remote, Local: local, Type: &eight,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{icmpBlock(8, securityGroupRuleICMPAny)}, rule[isSecurityGroupRuleProtocolICMP])
+
+	_, rule, err = flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &icmp, Remote: remote, Local: local,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{icmpBlock(securityGroupRuleICMPAny, securityGroupRuleICMPAny)}, rule[isSecurityGroupRuleProtocolICMP])
+
+	_, rule, err = flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &udp, Remote: remote, Local: local, PortMin: &port, PortMax: &port,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{portsBlock(443, 443)}, rule[isSecurityGroupRuleProtocolUDP])
+	assert.Nil(t, rule[isSecurityGroupRuleProtocolTCP])
+
+	_, rule, err = flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &all, Remote: &vpcv1.SecurityGroupRuleRemote{ID: stringPtr("r006-sg")}, Local: local,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "r006-sg", rule[isSecurityGroupRuleRemote])
+	assert.Nil(t, rule[isSecurityGroupRuleProtocolICMP])
+
+	// The base rule type is flattened by its protocol
+	_, rule, err = flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRule{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &tcp, Remote: &vpcv1.SecurityGroupRuleRemote{Address: stringPtr("10.1.2.3")}, Local: local,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{portsBlock(1, 65535)}, rule[isSecurityGroupRuleProtocolTCP])
+	assert.Equal(t, "10.1.2.3", rule[isSecurityGroupRuleRemote])
+
+	_, _, err = flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRule{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &gre, Remote: remote, Local: local,
+	})
+	assert.Error(t, err)
+	_, _, err = flattenSecurityGroupInlineRule(nil)
+	assert.Error(t, err)
+}
+
+func TestSecurityGroupInlineRuleKey(t *testing.T) {
+	echoReply := inlineRule("inbound", "0.0.0.0/0", "icmp", icmpBlock(0, 0))
+	anyICMP := inlineRule("inbound", "0.0.0.0/0", "icmp", icmpBlock(securityGroupRuleICMPAny, securityGroupRuleICMPAny))
+	assert.NotEqual(t, securityGroupInlineRuleKey(echoReply), securityGroupInlineRuleKey(anyICMP))
+	assert.Equal(t, securityGroupInlineRuleKey(anyICMP), securityGroupInlineRuleKey(inlineRule("inbound", "0.0.0.0/0", "icmp", nil)))
+
+	ssh := inlineRule("inbound", "0.0.0.0/0", "tcp", portsBlock(22, 22))
+	assert.NotEqual(t, securityGroupInlineRuleKey(ssh), securityGroupInlineRuleKey(inlineRule("inbound", "0.0.0.0/0", "udp", portsBlock(22, 22))))
+	assert.NotEqual(t, securityGroupInlineRuleKey(ssh), securityGroupInlineRuleKey(inlineRule("inbound", "0.0.0.0/0", "tcp", portsBlock(22, 23))))
+	assert.NotEqual(t, securityGroupInlineRuleKey(ssh), securityGroupInlineRuleKey(inlineRule("outbound", "0.0.0.0/0", "tcp", portsBlock(22, 22))))
+	assert.NotEqual(t, securityGroupInlineRuleKey(ssh), securityGroupInlineRuleKey(inlineRule("inbound", "10.0.0.0/8", "tcp", portsBlock(22, 22))))
+	assert.Equal(t, securityGroupInlineRuleKey(inlineRule("inbound", "0.0.0.0/0", "tcp", portsBlock(1, 65535))),
+		securityGroupInlineRuleKey(inlineRule("inbound", "0.0.0.0/0", "tcp", nil)))
+	assert.NotEqual(t, securityGroupInlineRuleKey(inlineRule("inbound", "0.0.0.0/0", "all", nil)),
+		securityGroupInlineRuleKey(inlineRule("inbound", "0.0.0.0/0", "tcp", nil)))
+
+	// The rules of the security group match the configured ones
+	id, direction, ipVersion, icmp, tcp := "rule-id", "inbound", "ipv4", "icmp", "tcp"
+	zero, ports := int64(0), int64(22)
+	remote := &vpcv1.SecurityGroupRuleRemote{CIDRBlock: stringPtr("0.0.0.0/0")}
+	local := &vpcv1.SecurityGroupRuleLocal{CIDRBlock: stringPtr("0.0.0.0/0")}
+	_, rule, err := flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &icmp, Remote: remote, Local: local, Type: &zero, Code: &zero,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, securityGroupInlineRuleKey(echoReply), securityGroupInlineRuleKey(rule))
+	_, rule, err = flattenSecurityGroupInlineRule(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+		ID: &id, Direction: &direction, IPVersion: &ipVersion, Protocol: &tcp, Remote: remote, Local: local, PortMin: &ports, PortMax: &ports,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, securityGroupInlineRuleKey(ssh), securityGroupInlineRuleKey(rule))
+
+	rules := schema.NewSet(resourceIBMISSecurityGroupInlineRuleHash, []interface{}{echoReply, anyICMP, ssh, rule})
+	assert.Equal(t, 3, rules.Len())
+	assert.NoError(t, validateSecurityGroupInlineRules(rules))
+	assert.Error(t, validateSecurityGroupInlineRules(schema.NewSet(resourceIBMISSecurityGroupInlineRuleHash, []interface{}{
+		inlineRule("inbound", "0.0.0.0/0", "icmp", icmpBlock(securityGroupRuleICMPAny, 0)),
+	})))
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -1192,7 +1192,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 			oldList, newList := d.GetChange(replicaShareAccessTagsSchema)
 			err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *share.ReplicaShare.CRN, "", isAccessTagType)
 			if err != nil {
-				log.Printf(
+				flex.AddWarningf(d,
 					"Error creating replica file share (%s) access tags: %s", d.Id(), err)
 			}
 		}
@@ -1203,7 +1203,7 @@ func resourceIbmIsShareCreate(context context.Context, d *schema.ResourceData, m
 		oldList, newList := d.GetChange(isFileShareAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *share.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error creating file share (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -1521,7 +1521,7 @@ func resourceIbmIsShareUpdate(context context.Context, d *schema.ResourceData, m
 		oldList, newList := d.GetChange(isFileShareAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error updating shares (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -2137,7 +2137,7 @@ func shareUpdate(vpcClient *vpcv1.VpcV1, context context.Context, d *schema.Reso
 		oldList, newList := d.GetChange(accessTagsSchema)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(shareCRN).(string), "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error updating shares (%s) access tags: %s", d.Id(), err)
 		}
 	}
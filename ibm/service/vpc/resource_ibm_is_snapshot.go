@@ -634,8 +634,8 @@ func resourceIBMISSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshot.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on create of resource snapshot (%s) access tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on create of resource snapshot (%s) access tags: %s", d.Id(), err)
 		}
 	}
 	return resourceIBMISSnapshotRead(d, meta)
@@ -994,8 +994,8 @@ func snapshotUpdate(d *schema.ResourceData, meta interface{}, id, name string, h
 		oldList, newList := d.GetChange(isSnapshotAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSnapshotCRN).(string), "", isAccessTagType)
 		if err != nil {
-			log.Printf(
-				"[ERROR] Error on update of resource snapshot (%s) access tags: %s", d.Id(), err)
+			flex.AddWarningf(d,
+				"Error on update of resource snapshot (%s) access tags: %s", d.Id(), err)
 		}
 	}
 	return nil
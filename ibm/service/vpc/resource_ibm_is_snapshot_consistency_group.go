@@ -370,7 +370,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc snapshot consistency group (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -379,7 +379,7 @@ func resourceIBMIsSnapshotConsistencyGroupCreate(context context.Context, d *sch
 		oldList, newList := d.GetChange("access_tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource snapshot consistency group (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -612,7 +612,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpc snapshot consistency group (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -633,7 +633,7 @@ func resourceIBMIsSnapshotConsistencyGroupUpdate(context context.Context, d *sch
 		oldList, newList := d.GetChange("access_tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *snapshotConsistencyGroup.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource VPC snapshot consistency group  (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -234,7 +234,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of vpc SSH Key (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -243,7 +243,7 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 		oldList, newList := d.GetChange(isKeyAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of vpc SSH Key (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -343,7 +343,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		oldList, newList := d.GetChange(isKeyTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpc SSH Key (%s) tags: %s", id, err)
 		}
 	}
@@ -358,7 +358,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		oldList, newList := d.GetChange(isKeyAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *key.CRN, "", isKeyAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpc SSH Key (%s) access tags: %s", id, err)
 		}
 	}
@@ -402,7 +402,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 		oldList, newList := d.GetChange(isSubnetTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource subnet (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -411,7 +411,7 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *subnet.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource subnet (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -541,7 +541,7 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		oldList, newList := d.GetChange(isSubnetTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource subnet (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -550,7 +550,7 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		oldList, newList := d.GetChange(isSubnetAccessTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get(isSubnetCRN).(string), "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource subnet (%s) access tags: %s", d.Id(), err)
 		}
 	}
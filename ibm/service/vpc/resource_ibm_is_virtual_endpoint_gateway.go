@@ -413,7 +413,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of VPE (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -422,7 +422,7 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 		oldList, newList := d.GetChange(isVirtualEndpointGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of VPE (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -512,7 +512,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 			oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isUserTagType)
 			if err != nil {
-				log.Printf(
+				flex.AddWarningf(d,
 					"Error on update of VPE (%s) tags: %s", d.Id(), err)
 			}
 		}
@@ -521,7 +521,7 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 			oldList, newList := d.GetChange(isVirtualEndpointGatewayAccessTags)
 			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *endpointGateway.CRN, "", isAccessTagType)
 			if err != nil {
-				log.Printf(
+				flex.AddWarningf(d,
 					"Error on update of VPE (%s) access tags: %s", d.Id(), err)
 			}
 		}
@@ -480,7 +480,7 @@ func resourceIBMIsVirtualNetworkInterfaceCreate(context context.Context, d *sche
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *virtualNetworkInterface.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vni (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -488,7 +488,7 @@ func resourceIBMIsVirtualNetworkInterfaceCreate(context context.Context, d *sche
 		oldList, newList := d.GetChange("access_tags")
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *virtualNetworkInterface.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vni (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -652,7 +652,7 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 		oldList, newList := d.GetChange("tags")
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vni (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -661,7 +661,7 @@ func resourceIBMIsVirtualNetworkInterfaceUpdate(context context.Context, d *sche
 		oldList, newList := d.GetChange("access_tags")
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string), "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vni (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -569,7 +569,7 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		oldList, newList := d.GetChange(isVolumeAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc volume (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -769,7 +769,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vol.CRN, "", isVolumeAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpc volume (%s) access tags: %s", id, err)
 		}
 	}
@@ -791,7 +791,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -799,7 +799,7 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 		oldList, newList := d.GetChange(isVPCAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -1253,7 +1253,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		oldList, newList := d.GetChange(isVPCTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpc (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -1268,7 +1268,7 @@ func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		oldList, newList := d.GetChange(isVPCAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpc.CRN, "", isVPCAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource VPC (%s) access tags: %s", d.Id(), err)
 		}
 	}
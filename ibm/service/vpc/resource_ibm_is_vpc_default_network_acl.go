@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMISVPCDefaultNetworkACL adopts the default network ACL of a VPC.
+// Its rules are replaced with the configured rules, and restored to the rules
+// of a new VPC when the resource is destroyed.
+func ResourceIBMISVPCDefaultNetworkACL() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISVPCDefaultNetworkACLCreate,
+		Read:     resourceIBMISVPCDefaultNetworkACLRead,
+		Update:   resourceIBMISVPCDefaultNetworkACLUpdate,
+		Delete:   resourceIBMISVPCDefaultNetworkACLDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			isNetworkACLVPC: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier.",
+			},
+			isNetworkACLName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLName),
+				Description:  "The name of the default network ACL.",
+			},
+			isNetworkACLRules: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The ordered rules of the default network ACL, which replace its existing rules. Without rules, the default network ACL denies all traffic.",
+				Elem: &schema.Resource{
+					Schema: makeIBMISNetworkACLInlineRuleSchema(),
+				},
+			},
+			isNetworkACLCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the default network ACL.",
+			},
+			isNetworkACLResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource group ID of the default network ACL.",
+			},
+		},
+	}
+}
+
+func resourceIBMISVPCDefaultNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID := d.Get(isNetworkACLVPC).(string)
+	getVPCDefaultNetworkACLOptions := &vpcv1.GetVPCDefaultNetworkACLOptions{
+		ID: &vpcID,
+	}
+	nwacl, response, err := sess.GetVPCDefaultNetworkACL(getVPCDefaultNetworkACLOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting default Network ACL of VPC (%s): %s\n%s", vpcID, err, response)
+	}
+	d.SetId(*nwacl.ID)
+
+	if name, ok := d.GetOk(isNetworkACLName); ok && name.(string) != *nwacl.Name {
+		err = updateVPCDefaultNetworkACLName(sess, d.Id(), name.(string))
+		if err != nil {
+			return err
+		}
+	}
+	err = replaceVPCDefaultNetworkACLRules(sess, d.Id(), d.Get(isNetworkACLRules).([]interface{}))
+	if err != nil {
+		return err
+	}
+	return resourceIBMISVPCDefaultNetworkACLRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+	getNetworkAclOptions := &vpcv1.GetNetworkACLOptions{
+		ID: &id,
+	}
+	nwacl, response, err := sess.GetNetworkACL(getNetworkAclOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting Network ACL(%s) : %s\n%s", id, err, response)
+	}
+	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
+	d.Set(isNetworkACLName, *nwacl.Name)
+	d.Set(isNetworkACLCRN, *nwacl.CRN)
+	if nwacl.ResourceGroup != nil {
+		d.Set(isNetworkACLResourceGroup, *nwacl.ResourceGroup.ID)
+	}
+	if err = d.Set(isNetworkACLRules, flattenNetworkACLRules(nwacl.Rules, len(nwacl.Subnets))); err != nil {
+		return fmt.Errorf("[ERROR] Error setting Network ACL rules: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISVPCDefaultNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	if d.HasChange(isNetworkACLName) {
+		err = updateVPCDefaultNetworkACLName(sess, d.Id(), d.Get(isNetworkACLName).(string))
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange(isNetworkACLRules) {
+		err = replaceVPCDefaultNetworkACLRules(sess, d.Id(), d.Get(isNetworkACLRules).([]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMISVPCDefaultNetworkACLRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	// The default network ACL is deleted along with its VPC, so only its rules
+	// are restored
+	err = replaceVPCDefaultNetworkACLRules(sess, d.Id(), vpcDefaultNetworkACLRules())
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func updateVPCDefaultNetworkACLName(sess *vpcv1.VpcV1, id, name string) error {
+	networkACLPatchModel := &vpcv1.NetworkACLPatch{
+		Name: &name,
+	}
+	networkACLPatch, err := networkACLPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLPatch: %s", err)
+	}
+	updateNetworkACLOptions := &vpcv1.UpdateNetworkACLOptions{
+		ID:              &id,
+		NetworkACLPatch: networkACLPatch,
+	}
+	_, response, err := sess.UpdateNetworkACL(updateNetworkACLOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating Network ACL(%s) : %s\n%s", id, err, response)
+	}
+	return nil
+}
+
+// replaceVPCDefaultNetworkACLRules replaces the rules of the network ACL with
+// the inline rules, in order. Like reconcileSecurityGroupRules, the inline
+// rules are created, after the existing rules, before these are deleted, so
+// that the traffic allowed by both is never interrupted. The names of the rules
+// of a network ACL are unique, so the existing rules named like an inline rule
+// are renamed first.
+func replaceVPCDefaultNetworkACLRules(sess *vpcv1.VpcV1, id string, rules []interface{}) error {
+	err := validateInlineRules(rules)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		names[rule.(map[string]interface{})[isNetworkACLRuleName].(string)] = true
+	}
+	var stale []string
+	start := ""
+	for {
+		listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &id,
+		}
+		if start != "" {
+			listNetworkACLRulesOptions.Start = &start
+		}
+		ruleCollection, response, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Listing network ACL rules : %s\n%s", err, response)
+		}
+		for _, rule := range ruleCollection.Rules {
+			ruleID, name := networkACLRuleItemIDName(rule)
+			if ruleID == "" {
+				continue
+			}
+			stale = append(stale, ruleID)
+			if names[name] {
+				err = renameNetworkACLRule(sess, id, ruleID, "stale-"+ruleID)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		start = flex.GetNext(ruleCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	err = createInlineRules(sess, id, rules)
+	if err != nil {
+		return err
+	}
+	for _, ruleID := range stale {
+		ruleID := ruleID
+		deleteNetworkACLRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+			NetworkACLID: &id,
+			ID:           &ruleID,
+		}
+		response, err := sess.DeleteNetworkACLRule(deleteNetworkACLRuleOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error Deleting network ACL rule : %s\n%s", err, response)
+		}
+	}
+	return nil
+}
+
+// networkACLRuleItemIDName returns the ID and the name of a rule of a network
+// ACL.
+func networkACLRuleItemIDName(rule vpcv1.NetworkACLRuleItemIntf) (string, string) {
+	var ruleID, name *string
+	switch rule := rule.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		ruleID, name = rule.ID, rule.Name
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		ruleID, name = rule.ID, rule.Name
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		ruleID, name = rule.ID, rule.Name
+	case *vpcv1.NetworkACLRuleItem:
+		ruleID, name = rule.ID, rule.Name
+	}
+	if ruleID == nil || name == nil {
+		return "", ""
+	}
+	return *ruleID, *name
+}
+
+func renameNetworkACLRule(sess *vpcv1.VpcV1, id, ruleID, name string) error {
+	networkACLRulePatchModel := &vpcv1.NetworkACLRulePatch{
+		Name: &name,
+	}
+	networkACLRulePatch, err := networkACLRulePatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for NetworkACLRulePatch: %s", err)
+	}
+	updateNetworkACLRuleOptions := &vpcv1.UpdateNetworkACLRuleOptions{
+		NetworkACLID:        &id,
+		ID:                  &ruleID,
+		NetworkACLRulePatch: networkACLRulePatch,
+	}
+	_, response, err := sess.UpdateNetworkACLRule(updateNetworkACLRuleOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating network ACL rule (%s) : %s\n%s", ruleID, err, response)
+	}
+	return nil
+}
+
+// vpcDefaultNetworkACLRules returns the rules of the default network ACL of a
+// new VPC, which allow all inbound and outbound traffic.
+func vpcDefaultNetworkACLRules() []interface{} {
+	rules := make([]interface{}, 0, 2)
+	for _, direction := range []string{"inbound", "outbound"} {
+		rules = append(rules, map[string]interface{}{
+			isNetworkACLRuleName:        "allow-" + direction,
+			isNetworkACLRuleAction:      "allow",
+			isNetworkACLRuleSource:      "0.0.0.0/0",
+			isNetworkACLRuleDestination: "0.0.0.0/0",
+			isNetworkACLRuleDirection:   direction,
+			isNetworkACLRuleICMP:        []interface{}{},
+			isNetworkACLRuleTCP:         []interface{}{},
+			isNetworkACLRuleUDP:         []interface{}{},
+		})
+	}
+	return rules
+}
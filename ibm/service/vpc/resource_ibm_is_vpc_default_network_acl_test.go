@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPCDefaultNetworkACL_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfdnwacl-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfdnwacl-acl-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultNetworkACLConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_vpc_default_network_acl.testacc_dnwacl", "id", "ibm_is_vpc.testacc_vpc", "default_network_acl"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_network_acl.testacc_dnwacl", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_network_acl.testacc_dnwacl", "rules.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_network_acl.testacc_dnwacl", "rules.2.action", "deny"),
+					testAccCheckIBMISVPCDefaultNetworkACLRules("ibm_is_vpc.testacc_vpc", 3),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_default_network_acl.testacc_dnwacl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying the resource restores the rules of a new VPC
+				Config: testAccCheckIBMISVPCDefaultSecurityGroupVPCConfig(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCDefaultNetworkACLRules("ibm_is_vpc.testacc_vpc", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCDefaultNetworkACLRules(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		nwaclID := rs.Primary.Attributes["default_network_acl"]
+		getnwacloptions := &vpcv1.GetNetworkACLOptions{
+			ID: &nwaclID,
+		}
+		nwacl, _, err := sess.GetNetworkACL(getnwacloptions)
+		if err != nil {
+			return err
+		}
+		if len(nwacl.Rules) != count {
+			return fmt.Errorf("Default network ACL %s has %d rules, expected %d", nwaclID, len(nwacl.Rules), count)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISVPCDefaultNetworkACLConfig(vpcname, name string) string {
+	return testAccCheckIBMISVPCDefaultSecurityGroupVPCConfig(vpcname) + fmt.Sprintf(`
+
+resource "ibm_is_vpc_default_network_acl" "testacc_dnwacl" {
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+
+	rules {
+		name        = "allow-https-inbound"
+		action      = "allow"
+		source      = "0.0.0.0/0"
+		destination = "0.0.0.0/0"
+		direction   = "inbound"
+		tcp {
+			port_min = 443
+			port_max = 443
+		}
+	}
+	rules {
+		name        = "allow-https-outbound"
+		action      = "allow"
+		source      = "0.0.0.0/0"
+		destination = "0.0.0.0/0"
+		direction   = "outbound"
+		tcp {
+			source_port_min = 443
+			source_port_max = 443
+		}
+	}
+	rules {
+		name        = "deny-all-inbound"
+		action      = "deny"
+		source      = "0.0.0.0/0"
+		destination = "0.0.0.0/0"
+		direction   = "inbound"
+	}
+}`, name)
+}
@@ -0,0 +1,411 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isVPCDefaultRoutingTableRoute = "route"
+	isRoutingTableRoutePriority   = "priority"
+)
+
+// ResourceIBMISVPCDefaultRoutingTable adopts the default routing table of a
+// VPC. Its user routes are replaced with the configured routes, and deleted
+// when the resource is destroyed, as a new VPC has none.
+func ResourceIBMISVPCDefaultRoutingTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMISVPCDefaultRoutingTableCreate,
+		Read:   resourceIBMISVPCDefaultRoutingTableRead,
+		Update: resourceIBMISVPCDefaultRoutingTableUpdate,
+		Delete: resourceIBMISVPCDefaultRoutingTableDelete,
+		// The ID is <vpc>/<routing_table>
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			rtVpcID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier.",
+			},
+			rtName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table", rtName),
+				Description:  "The name of the default routing table.",
+			},
+			isVPCDefaultRoutingTableRoute: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMISVPCDefaultRoutingTableRouteHash,
+				Description: "The user routes of the default routing table. The routes that are not configured are deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						rName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rName),
+							Description:  "The name of the route.",
+						},
+						rZone: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone to apply the route to. Traffic from subnets in this zone will be subject to this route.",
+						},
+						rDestination: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The destination of the route.",
+						},
+						rAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "deliver",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rAction),
+							Description:  "The action to perform with a packet matching the route.",
+						},
+						rNextHop: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "0.0.0.0",
+							Description: "If action is deliver, the IP address or VPN gateway connection ID of the next hop. For other action values, 0.0.0.0.",
+						},
+						isRoutingTableRoutePriority: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", isRoutingTableRoutePriority),
+							Description:  "The route's priority. Smaller values have higher priority.",
+						},
+					},
+				},
+			},
+			rtIsDefault: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether this is the default routing table for this VPC",
+			},
+			rtResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource group ID of the default routing table.",
+			},
+		},
+	}
+}
+
+func resourceIBMISVPCDefaultRoutingTableCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID := d.Get(rtVpcID).(string)
+	getVPCDefaultRoutingTableOptions := sess.NewGetVPCDefaultRoutingTableOptions(vpcID)
+	routingTable, response, err := sess.GetVPCDefaultRoutingTable(getVPCDefaultRoutingTableOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting default Routing Table of VPC (%s): %s\n%s", vpcID, err, response)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vpcID, *routingTable.ID))
+
+	if name, ok := d.GetOk(rtName); ok && name.(string) != *routingTable.Name {
+		err = updateVPCDefaultRoutingTableName(sess, vpcID, *routingTable.ID, name.(string))
+		if err != nil {
+			return err
+		}
+	}
+	err = reconcileVPCDefaultRoutingTableRoutes(sess, vpcID, *routingTable.ID, d.Get(isVPCDefaultRoutingTableRoute).(*schema.Set))
+	if err != nil {
+		return err
+	}
+	return resourceIBMISVPCDefaultRoutingTableRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultRoutingTableRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID, tableID, err := parseVPCDefaultRoutingTableID(d.Id())
+	if err != nil {
+		return err
+	}
+	getVpcRoutingTableOptions := sess.NewGetVPCRoutingTableOptions(vpcID, tableID)
+	routingTable, response, err := sess.GetVPCRoutingTable(getVpcRoutingTableOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error Getting VPC Routing table: %s\n%s", err, response)
+	}
+	d.Set(rtVpcID, vpcID)
+	d.Set(rtName, *routingTable.Name)
+	d.Set(rtIsDefault, *routingTable.IsDefault)
+	if routingTable.ResourceGroup != nil {
+		d.Set(rtResourceGroup, *routingTable.ResourceGroup.ID)
+	}
+
+	routes, err := listVPCRoutingTableRoutes(sess, vpcID, tableID)
+	if err != nil {
+		return err
+	}
+	userRoutes := make([]interface{}, 0, len(routes))
+	for _, route := range routes {
+		if userRoute := flattenVPCDefaultRoutingTableRoute(route); userRoute != nil {
+			userRoutes = append(userRoutes, userRoute)
+		}
+	}
+	if err = d.Set(isVPCDefaultRoutingTableRoute, schema.NewSet(resourceIBMISVPCDefaultRoutingTableRouteHash, userRoutes)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting VPC Routing table routes: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISVPCDefaultRoutingTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID, tableID, err := parseVPCDefaultRoutingTableID(d.Id())
+	if err != nil {
+		return err
+	}
+	if d.HasChange(rtName) {
+		err = updateVPCDefaultRoutingTableName(sess, vpcID, tableID, d.Get(rtName).(string))
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange(isVPCDefaultRoutingTableRoute) {
+		err = reconcileVPCDefaultRoutingTableRoutes(sess, vpcID, tableID, d.Get(isVPCDefaultRoutingTableRoute).(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMISVPCDefaultRoutingTableRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultRoutingTableDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID, tableID, err := parseVPCDefaultRoutingTableID(d.Id())
+	if err != nil {
+		return err
+	}
+	// The default routing table is deleted along with its VPC, so only its
+	// user routes are deleted
+	err = reconcileVPCDefaultRoutingTableRoutes(sess, vpcID, tableID, schema.NewSet(resourceIBMISVPCDefaultRoutingTableRouteHash, nil))
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func parseVPCDefaultRoutingTableID(id string) (vpcID, tableID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpcID/routingTableID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func updateVPCDefaultRoutingTableName(sess *vpcv1.VpcV1, vpcID, tableID, name string) error {
+	routingTablePatchModel := &vpcv1.RoutingTablePatch{
+		Name: &name,
+	}
+	routingTablePatch, err := routingTablePatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for RoutingTablePatch: %s", err)
+	}
+	updateVpcRoutingTableOptions := sess.NewUpdateVPCRoutingTableOptions(vpcID, tableID, routingTablePatch)
+	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating VPC Routing table: %s\n%s", err, response)
+	}
+	return nil
+}
+
+func listVPCRoutingTableRoutes(sess *vpcv1.VpcV1, vpcID, tableID string) ([]vpcv1.Route, error) {
+	start := ""
+	allrecs := []vpcv1.Route{}
+	for {
+		listVpcRoutingTableRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(vpcID, tableID)
+		if start != "" {
+			listVpcRoutingTableRoutesOptions.Start = &start
+		}
+		result, response, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTableRoutesOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Listing VPC Routing table routes: %s\n%s", err, response)
+		}
+		start = flex.GetNext(result.Next)
+		allrecs = append(allrecs, result.Routes...)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+// flattenVPCDefaultRoutingTableRoute returns the route block of a route, or nil
+// for the learned and service routes, which cannot be managed.
+func flattenVPCDefaultRoutingTableRoute(route vpcv1.Route) map[string]interface{} {
+	if route.Origin != nil && *route.Origin != "user" {
+		return nil
+	}
+	r := map[string]interface{}{
+		rName:                       *route.Name,
+		rDestination:                *route.Destination,
+		rAction:                     *route.Action,
+		rNextHop:                    "0.0.0.0",
+		isRoutingTableRoutePriority: 0,
+		rZone:                       "",
+	}
+	if nextHop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok && nextHop != nil {
+		if nextHop.Address != nil {
+			r[rNextHop] = *nextHop.Address
+		}
+		if nextHop.ID != nil {
+			r[rNextHop] = *nextHop.ID
+		}
+	}
+	if route.Priority != nil {
+		r[isRoutingTableRoutePriority] = int(*route.Priority)
+	}
+	if route.Zone != nil {
+		r[rZone] = *route.Zone.Name
+	}
+	return r
+}
+
+// vpcDefaultRoutingTableRouteKey returns the destination and next hop of a
+// route block, by which the configured routes and the routes of the table are
+// matched.
+func vpcDefaultRoutingTableRouteKey(route map[string]interface{}) string {
+	return fmt.Sprintf("%s-%s", route[rDestination], route[rNextHop])
+}
+
+// resourceIBMISVPCDefaultRoutingTableRouteHash leaves the name out, so that a
+// renamed route is planned as an update.
+func resourceIBMISVPCDefaultRoutingTableRouteHash(v interface{}) int {
+	route := v.(map[string]interface{})
+	return schema.HashString(fmt.Sprintf("%s-%s-%s-%d", vpcDefaultRoutingTableRouteKey(route), route[rZone],
+		route[rAction], route[isRoutingTableRoutePriority]))
+}
+
+// reconcileVPCDefaultRoutingTableRoutes makes the user routes of the routing
+// table match the route set. A route of the table matching a configured route
+// by vpcDefaultRoutingTableRouteKey, in the same zone and with the same action,
+// which cannot be updated, is kept and its name and priority are updated in
+// place. The obsolete routes are deleted before the missing routes are
+// created, as two routes cannot share a destination, zone and priority unless
+// they are both ECMP routes.
+func reconcileVPCDefaultRoutingTableRoutes(sess *vpcv1.VpcV1, vpcID, tableID string, routes *schema.Set) error {
+	wanted := make(map[string][]map[string]interface{}, routes.Len())
+	for _, r := range routes.List() {
+		route := r.(map[string]interface{})
+		key := vpcDefaultRoutingTableRouteKey(route)
+		wanted[key] = append(wanted[key], route)
+	}
+	tableRoutes, err := listVPCRoutingTableRoutes(sess, vpcID, tableID)
+	if err != nil {
+		return err
+	}
+	updates := make(map[string]map[string]interface{})
+	var obsolete []string
+	for _, tableRoute := range tableRoutes {
+		route := flattenVPCDefaultRoutingTableRoute(tableRoute)
+		if route == nil {
+			continue
+		}
+		key := vpcDefaultRoutingTableRouteKey(route)
+		matched := -1
+		for i, candidate := range wanted[key] {
+			if candidate[rZone] == route[rZone] && candidate[rAction] == route[rAction] {
+				matched = i
+				break
+			}
+		}
+		if matched < 0 {
+			obsolete = append(obsolete, *tableRoute.ID)
+			continue
+		}
+		candidate := wanted[key][matched]
+		wanted[key] = append(wanted[key][:matched:matched], wanted[key][matched+1:]...)
+		if candidate[rName] != route[rName] || candidate[isRoutingTableRoutePriority] != route[isRoutingTableRoutePriority] {
+			updates[*tableRoute.ID] = candidate
+		}
+	}
+
+	for _, routeID := range obsolete {
+		deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(vpcID, tableID, routeID)
+		response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting VPC Routing table route: %s\n%s", err, response)
+		}
+	}
+	for routeID, route := range updates {
+		routePatchModel := &vpcv1.RoutePatch{
+			Name:     core.StringPtr(route[rName].(string)),
+			Priority: core.Int64Ptr(int64(route[isRoutingTableRoutePriority].(int))),
+		}
+		routePatch, err := routePatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for RoutePatch: %s", err)
+		}
+		updateVpcRoutingTableRouteOptions := sess.NewUpdateVPCRoutingTableRouteOptions(vpcID, tableID, routeID, routePatch)
+		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating VPC Routing table route: %s\n%s", err, response)
+		}
+	}
+
+	for _, missing := range wanted {
+		for _, route := range missing {
+			if err := createVPCDefaultRoutingTableRoute(sess, vpcID, tableID, route); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func createVPCDefaultRoutingTableRoute(sess *vpcv1.VpcV1, vpcID, tableID string, route map[string]interface{}) error {
+	zone := &vpcv1.ZoneIdentityByName{
+		Name: core.StringPtr(route[rZone].(string)),
+	}
+	createVpcRoutingTableRouteOptions := sess.NewCreateVPCRoutingTableRouteOptions(vpcID, tableID, route[rDestination].(string), zone)
+	createVpcRoutingTableRouteOptions.SetName(route[rName].(string))
+	createVpcRoutingTableRouteOptions.SetAction(route[rAction].(string))
+	createVpcRoutingTableRouteOptions.SetPriority(int64(route[isRoutingTableRoutePriority].(int)))
+	if nextHop := route[rNextHop].(string); nextHop != "0.0.0.0" {
+		if net.ParseIP(nextHop) == nil {
+			createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RouteNextHopPrototype{
+				ID: core.StringPtr(nextHop),
+			})
+		} else {
+			createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RouteNextHopPrototype{
+				Address: core.StringPtr(nextHop),
+			})
+		}
+	}
+	_, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating VPC Routing table route: %s\n%s", err, response)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPCDefaultRoutingTable_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfdrt-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfdrt-rt-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultRoutingTableConfig(vpcname, name, "drop"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_routing_table.testacc_drt", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_routing_table.testacc_drt", "is_default", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_routing_table.testacc_drt", "route.#", "1"),
+					testAccCheckIBMISVPCDefaultRoutingTableRoutes("ibm_is_vpc.testacc_vpc", 1),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCDefaultRoutingTableConfig(vpcname, name, "delegate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_vpc_default_routing_table.testacc_drt", "route.*", map[string]string{
+							"action": "delegate",
+						}),
+					testAccCheckIBMISVPCDefaultRoutingTableRoutes("ibm_is_vpc.testacc_vpc", 1),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_default_routing_table.testacc_drt",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying the resource deletes the user routes
+				Config: testAccCheckIBMISVPCDefaultSecurityGroupVPCConfig(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCDefaultRoutingTableRoutes("ibm_is_vpc.testacc_vpc", 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCDefaultRoutingTableRoutes(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		tableID := rs.Primary.Attributes["default_routing_table"]
+		listroutesoptions := sess.NewListVPCRoutingTableRoutesOptions(rs.Primary.ID, tableID)
+		routes, _, err := sess.ListVPCRoutingTableRoutes(listroutesoptions)
+		if err != nil {
+			return err
+		}
+		userRoutes := 0
+		for _, route := range routes.Routes {
+			if strings.EqualFold(*route.Origin, "user") {
+				userRoutes++
+			}
+		}
+		if userRoutes != count {
+			return fmt.Errorf("Default routing table %s has %d user routes, expected %d", tableID, userRoutes, count)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISVPCDefaultRoutingTableConfig(vpcname, name, action string) string {
+	return testAccCheckIBMISVPCDefaultSecurityGroupVPCConfig(vpcname) + fmt.Sprintf(`
+
+resource "ibm_is_vpc_default_routing_table" "testacc_drt" {
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+
+	route {
+		name        = "tfdrt-route"
+		zone        = "%s"
+		destination = "192.168.4.0/24"
+		action      = "%s"
+	}
+}`, name, acc.ISZoneName, action)
+}
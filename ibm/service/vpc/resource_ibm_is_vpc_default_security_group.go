@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMISVPCDefaultSecurityGroup adopts the default security group of a
+// VPC. Its rules are replaced with the configured rules, and restored to the
+// rules of a new VPC when the resource is destroyed.
+func ResourceIBMISVPCDefaultSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMISVPCDefaultSecurityGroupCreate,
+		Read:     resourceIBMISVPCDefaultSecurityGroupRead,
+		Update:   resourceIBMISVPCDefaultSecurityGroupUpdate,
+		Delete:   resourceIBMISVPCDefaultSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return validateSecurityGroupInlineRules(diff.Get(isSecurityGroupRule).(*schema.Set))
+				}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupVPC: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier.",
+			},
+
+			isSecurityGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The name of the default security group.",
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group", isSecurityGroupName),
+			},
+
+			isSecurityGroupRule: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMISSecurityGroupInlineRuleHash,
+				Description: "The rules of the default security group. The rules that are not configured are deleted, so that no rule denies all traffic.",
+				Elem: &schema.Resource{
+					Schema: makeIBMISSecurityGroupInlineRuleSchema(),
+				},
+			},
+
+			isSecurityGroupCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the default security group.",
+			},
+
+			isSecurityGroupResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource group ID of the default security group.",
+			},
+		},
+	}
+}
+
+func resourceIBMISVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	vpcID := d.Get(isSecurityGroupVPC).(string)
+	getVPCDefaultSecurityGroupOptions := &vpcv1.GetVPCDefaultSecurityGroupOptions{
+		ID: &vpcID,
+	}
+	group, response, err := sess.GetVPCDefaultSecurityGroup(getVPCDefaultSecurityGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting default Security Group of VPC (%s): %s\n%s", vpcID, err, response)
+	}
+	d.SetId(*group.ID)
+
+	if name, ok := d.GetOk(isSecurityGroupName); ok && name.(string) != *group.Name {
+		err = updateVPCDefaultSecurityGroupName(sess, d.Id(), name.(string))
+		if err != nil {
+			return err
+		}
+	}
+	err = reconcileSecurityGroupRules(sess, d.Id(), d.Get(isSecurityGroupRule).(*schema.Set))
+	if err != nil {
+		return err
+	}
+	return resourceIBMISVPCDefaultSecurityGroupRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
+		ID: &id,
+	}
+	group, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting Security Group : %s\n%s", err, response)
+	}
+
+	d.Set(isSecurityGroupVPC, *group.VPC.ID)
+	d.Set(isSecurityGroupName, *group.Name)
+	d.Set(isSecurityGroupCRN, *group.CRN)
+	if group.ResourceGroup != nil {
+		d.Set(isSecurityGroupResourceGroup, *group.ResourceGroup.ID)
+	}
+	rules := make([]interface{}, 0, len(group.Rules))
+	for _, rule := range group.Rules {
+		_, inlineRule, err := flattenSecurityGroupInlineRule(rule)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, inlineRule)
+	}
+	if err = d.Set(isSecurityGroupRule, schema.NewSet(resourceIBMISSecurityGroupInlineRuleHash, rules)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting Security Group rule: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMISVPCDefaultSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	if d.HasChange(isSecurityGroupName) {
+		err = updateVPCDefaultSecurityGroupName(sess, d.Id(), d.Get(isSecurityGroupName).(string))
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange(isSecurityGroupRule) {
+		err = reconcileSecurityGroupRules(sess, d.Id(), d.Get(isSecurityGroupRule).(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMISVPCDefaultSecurityGroupRead(d, meta)
+}
+
+func resourceIBMISVPCDefaultSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	// The default security group is deleted along with its VPC, so only its
+	// rules are restored
+	err = reconcileSecurityGroupRules(sess, d.Id(), schema.NewSet(resourceIBMISSecurityGroupInlineRuleHash, vpcDefaultSecurityGroupRules(d.Id())))
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func updateVPCDefaultSecurityGroupName(sess *vpcv1.VpcV1, id, name string) error {
+	securityGroupPatchModel := &vpcv1.SecurityGroupPatch{
+		Name: &name,
+	}
+	securityGroupPatch, err := securityGroupPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for SecurityGroupPatch: %s", err)
+	}
+	updateSecurityGroupOptions := &vpcv1.UpdateSecurityGroupOptions{
+		ID:                 &id,
+		SecurityGroupPatch: securityGroupPatch,
+	}
+	_, response, err := sess.UpdateSecurityGroup(updateSecurityGroupOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating Security Group : %s\n%s", err, response)
+	}
+	return nil
+}
+
+// vpcDefaultSecurityGroupRules returns the rules of the default security group
+// of a new VPC: all inbound traffic from the members of the group, and all
+// outbound traffic.
+func vpcDefaultSecurityGroupRules(securityGroupID string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			isSecurityGroupRuleDirection: "inbound",
+			isSecurityGroupRuleIPVersion: isSecurityGroupRuleIPVersionDefault,
+			isSecurityGroupRuleRemote:    securityGroupID,
+			isSecurityGroupRuleLocal:     "0.0.0.0/0",
+		},
+		map[string]interface{}{
+			isSecurityGroupRuleDirection: "outbound",
+			isSecurityGroupRuleIPVersion: isSecurityGroupRuleIPVersionDefault,
+			isSecurityGroupRuleRemote:    "0.0.0.0/0",
+			isSecurityGroupRuleLocal:     "0.0.0.0/0",
+		},
+	}
+}
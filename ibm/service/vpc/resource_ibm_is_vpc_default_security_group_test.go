@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPCDefaultSecurityGroup_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfdsg-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfdsg-sg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCDefaultSecurityGroupConfig(vpcname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_vpc_default_security_group.testacc_dsg", "id", "ibm_is_vpc.testacc_vpc", "default_security_group"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_security_group.testacc_dsg", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_default_security_group.testacc_dsg", "rule.#", "1"),
+					testAccCheckIBMISVPCDefaultSecurityGroupRules("ibm_is_vpc.testacc_vpc", 1),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_default_security_group.testacc_dsg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying the resource restores the rules of a new VPC
+				Config: testAccCheckIBMISVPCDefaultSecurityGroupVPCConfig(vpcname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCDefaultSecurityGroupRules("ibm_is_vpc.testacc_vpc", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCDefaultSecurityGroupRules(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		securityGroupID := rs.Primary.Attributes["default_security_group"]
+		getsgoptions := &vpcv1.GetSecurityGroupOptions{
+			ID: &securityGroupID,
+		}
+		group, _, err := sess.GetSecurityGroup(getsgoptions)
+		if err != nil {
+			return err
+		}
+		if len(group.Rules) != count {
+			return fmt.Errorf("Default security group %s has %d rules, expected %d", securityGroupID, len(group.Rules), count)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISVPCDefaultSecurityGroupVPCConfig(vpcname string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}`, vpcname)
+}
+
+func testAccCheckIBMISVPCDefaultSecurityGroupConfig(vpcname, name string) string {
+	return testAccCheckIBMISVPCDefaultSecurityGroupVPCConfig(vpcname) + fmt.Sprintf(`
+
+resource "ibm_is_vpc_default_security_group" "testacc_dsg" {
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+
+	rule {
+		direction = "outbound"
+		remote    = "10.0.0.0/8"
+		tcp {
+			port_min = 443
+			port_max = 443
+		}
+	}
+}`, name)
+}
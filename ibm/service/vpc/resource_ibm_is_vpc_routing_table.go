@@ -336,7 +336,7 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 		oldList, newList := d.GetChange(rtTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *routeTable.CRN, "", rtUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource routing table (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -345,7 +345,7 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 		oldList, newList := d.GetChange(rtAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *routeTable.CRN, "", rtAccessTags)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource routing table (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -469,7 +469,7 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 		oldList, newList := d.GetChange(rtAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *routingTableGet.CRN, "", rtAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource routing table (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -478,7 +478,7 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 		oldList, newList := d.GetChange(rtTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *routingTableGet.CRN, "", rtUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource routing table (%s) tags: %s", d.Id(), err)
 		}
 	}
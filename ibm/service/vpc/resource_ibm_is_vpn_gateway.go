@@ -433,7 +433,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		oldList, newList := d.GetChange(isVPNGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -442,7 +442,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 		oldList, newList := d.GetChange(isVPNGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource VPN Gateway (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -634,7 +634,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		oldList, newList := d.GetChange(isVPNGatewayTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isUserTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpc Vpn Gateway (%s) tags: %s", id, err)
 		}
 	}
@@ -651,7 +651,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		oldList, newList := d.GetChange(isVPNGatewayAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN, "", isAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource VPC VPN Gateway  (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -568,7 +568,7 @@ func resourceIBMIsVPNServerCreate(context context.Context, d *schema.ResourceDat
 		oldList, newList := d.GetChange(isVPNServerAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on create of resource vpc (%s) access tags: %s", d.Id(), err)
 		}
 	}
@@ -940,7 +940,7 @@ func resourceIBMIsVPNServerUpdate(context context.Context, d *schema.ResourceDat
 		oldList, newList := d.GetChange(isVPNServerAccessTags)
 		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *vpnServer.CRN, "", isVPNServerAccessTagType)
 		if err != nil {
-			log.Printf(
+			flex.AddWarningf(d,
 				"Error on update of resource vpn server (%s) access tags: %s", d.Id(), err)
 		}
 	}
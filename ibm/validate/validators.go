@@ -447,23 +447,43 @@ func validateCIDRAddress() schema.SchemaValidateFunc {
 	}
 }
 
+// ReservedAddressRanges are the address ranges VPC address prefixes and
+// subnets must not overlap.
+var ReservedAddressRanges = []string{
+	"127.0.0.0/8",
+	"161.26.0.0/16",
+	"166.8.0.0/14",
+	"169.254.0.0/16",
+	"224.0.0.0/4",
+}
+
+// OverlapsReservedAddressRange reports whether cidr overlaps one of the
+// ReservedAddressRanges. Invalid CIDR blocks are not reported.
+func OverlapsReservedAddressRange(cidr string) bool {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	for _, r := range ReservedAddressRanges {
+		_, reserved, _ := net.ParseCIDR(r)
+		if reserved.Contains(ipNet.IP) || ipNet.Contains(reserved.IP) {
+			return true
+		}
+	}
+	return false
+}
+
 // validateOverlappingAddress...
 func validateOverlappingAddress() schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (ws []string, errors []error) {
-		nonOverlappingCIDR := map[string]bool{
-			"127.0.0.0/8":    true,
-			"161.26.0.0/16":  true,
-			"166.8.0.0/14":   true,
-			"169.254.0.0/16": true,
-			"224.0.0.0/4":    true,
-		}
-
 		address := v.(string)
-		_, found := nonOverlappingCIDR[address]
-		if found {
-			errors = append(errors, fmt.Errorf(
-				"%q the request is overlapping with reserved address ranges",
-				k))
+		for _, reserved := range ReservedAddressRanges {
+			if address == reserved {
+				errors = append(errors, fmt.Errorf(
+					"%q the request is overlapping with reserved address ranges",
+					k))
+				break
+			}
 		}
 		return
 	}
@@ -1,16 +1,42 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
 
 func main() {
 	log.Println("IBM Cloud Provider version", version.Version, version.VersionPrerelease, version.GitCommit)
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: provider.Provider,
-	})
+
+	// The generate-imports command writes the import blocks of the existing
+	// objects of the account, instead of serving the provider.
+	if len(os.Args) > 1 && os.Args[1] == "generate-imports" {
+		if err := provider.GenerateImports(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// The SDKv2 provider is listed first, so that it is configured before
+	// the framework provider, which uses its client session.
+	sdkProvider := provider.Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(context.Background(),
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(provider.NewFrameworkProvider(sdkProvider)),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = tf5server.Serve("registry.terraform.io/IBM-Cloud/ibm", muxServer.ProviderServer)
+	if err != nil {
+		log.Fatal(err)
+	}
 }